@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/relicta-tech/migrate/internal/detector"
+)
+
+// rcFile holds per-repository or per-user defaults for migrate's flags.
+const rcFile = ".migraterc.yaml"
+
+// rcFlags maps the keys of rcFile to the flags they set defaults for.
+var rcFlags = map[string]string{
+	"prefer":     "prefer",
+	"format":     "format",
+	"indent":     "indent",
+	"output":     "output",
+	"plugin_map": "plugin-map",
+}
+
+// loadDefaults applies rcFile and then loads the --plugin-map file before
+// a command runs.
+func loadDefaults(cmd *cobra.Command, args []string) error {
+	if err := loadRC(cmd, args); err != nil {
+		return err
+	}
+	return loadPluginMap(cmd, args)
+}
+
+// loadRC reads rcFile from the project directory, or else from the home
+// directory, and uses its values for the flags of cmd that were not given
+// on the command line. A relative plugin_map is read relative to rcFile.
+func loadRC(cmd *cobra.Command, args []string) error {
+	path := findRC(args)
+	if path == "" {
+		return nil
+	}
+	data, err := detector.ReadConfigFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		name, ok := rcFlags[key]
+		if !ok {
+			return fmt.Errorf("%s: unknown key %q (want prefer, format, indent, output or plugin_map)", path, key)
+		}
+		flag := cmd.Flags().Lookup(name)
+		if flag == nil || flag.Changed {
+			continue
+		}
+		value := rcValue(data[key])
+		if key == "plugin_map" && value != "" && !filepath.IsAbs(value) {
+			value = filepath.Join(filepath.Dir(path), value)
+		}
+		if err := cmd.Flags().Set(name, value); err != nil {
+			return fmt.Errorf("%s: invalid %s: %w", path, key, err)
+		}
+	}
+	return nil
+}
+
+// findRC returns the rcFile that applies: the one in the directory given
+// as the first argument (or the working directory), else the one in the
+// home directory, or "" when there is none.
+func findRC(args []string) string {
+	dir := "."
+	if len(args) > 0 {
+		if info, err := os.Stat(args[0]); err == nil && info.IsDir() {
+			dir = args[0]
+		}
+	}
+	candidates := []string{filepath.Join(dir, rcFile)}
+	if home, err := os.UserHomeDir(); err == nil {
+		candidates = append(candidates, filepath.Join(home, rcFile))
+	}
+	for _, path := range candidates {
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return ""
+}
+
+// rcValue formats an rcFile value as a flag value; a list becomes a
+// comma-separated one.
+func rcValue(value any) string {
+	if list, ok := value.([]any); ok {
+		items := make([]string, len(list))
+		for i, item := range list {
+			items[i] = fmt.Sprint(item)
+		}
+		return strings.Join(items, ",")
+	}
+	return fmt.Sprint(value)
+}
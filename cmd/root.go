@@ -2,9 +2,19 @@
 package cmd
 
 import (
+	"bufio"
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"os/exec"
+	"os/signal"
 	"path/filepath"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -19,6 +29,53 @@ var (
 	dryRun     bool
 	verbose    bool
 	force      bool
+	profile    string
+	strict     bool
+	reportFile string
+	configFile string
+	inputFmt   string
+	annotate   bool
+	toolName   string
+	outputDir  string
+	timeout    time.Duration
+	overrides  []string
+	explain    bool
+	noPushTags bool
+	noTag      bool
+	allowDirty bool
+	tagPrefix  string
+	branch     string
+	verify     bool
+	assumeYes  bool
+	mergePkg   bool
+	recursive  bool
+	defBranch  string
+	pluginMap  string
+	watch      bool
+	normAssets bool
+	since      string
+	sinceTime  time.Time
+	searchUp   bool
+	minCover   int
+	docStart   bool
+	failOnJS   bool
+	splitPlug  bool
+	outFormat  string
+	indent     int
+	prefer     []string
+	validSrc   bool
+	mergeTools bool
+	mergePref  []string
+	offline    bool
+
+	// detectOnly is set by the detect command, which reports the config
+	// without converting it and so does not fetch remote includes.
+	detectOnly bool
+
+	// runCache shares files read and remote includes fetched between the
+	// detections of one run, such as the consistency check's second pass
+	// over the project. preview starts a new one for every watch run.
+	runCache = detector.NewCache()
 
 	// Version info (set by ldflags)
 	version = "dev"
@@ -26,37 +83,219 @@ var (
 	date    = "unknown"
 )
 
+// status receives progress messages, warnings and next steps. It is
+// stderr when the config itself is written to stdout with --output -.
+var status io.Writer = os.Stdout
+
+// stdoutPath is the --output value that writes the config to stdout.
+const stdoutPath = "-"
+
+// defaultOutput is the --output default, the file relicta reads.
+const defaultOutput = "release.config.yaml"
+
 var rootCmd = &cobra.Command{
 	Use:   "migrate [directory]",
 	Short: "Migrate to Relicta from other release tools",
 	Long: `Migrate converts configuration from other release management tools to Relicta.
 
 Supported tools:
-  - semantic-release (.releaserc, .releaserc.json, .releaserc.yaml, release.config.js)
-  - release-it (.release-it.json, .release-it.yaml, .release-it.js, package.json)
+  - semantic-release (.releaserc, .releaserc.json, .releaserc.yaml, .releaserc.json5, .releaserc.js, release.config.js, release.config.mjs)
+  - release-it (.release-it.json, .release-it.yaml, .release-it.toml, .release-it.js, package.json)
   - standard-version (.versionrc, .versionrc.json, package.json)
+  - goreleaser (.goreleaser.yaml, .goreleaser.yml)
+  - release-drafter (.github/release-drafter.yaml, .github/release-drafter.yml)
+  - changesets (.changeset/config.json, .changeset/pre.json)
+  - maven-release (pom.xml with maven-release-plugin)
 
 Usage:
   migrate                    # Auto-detect and convert in current directory
   migrate /path/to/project   # Convert specific project
-  migrate --dry-run          # Preview without writing files`,
-	Args: cobra.MaximumNArgs(1),
-	RunE: runMigrate,
+  migrate --dry-run          # Preview without writing files
+  migrate --dry-run --explain  # Show how each source key was mapped
+  migrate --profile pro      # Prefer .goreleaser.pro.yml, .releaserc.pro.json, ...
+  migrate -c .releaserc --input-format yaml  # Convert a specific file
+  migrate -c "configs/*.releaserc.json"      # Convert every matching file
+  migrate --recursive        # Convert each package of a monorepo
+  migrate --set versioning.strategy=calver   # Override a generated field
+  migrate --watch            # Re-preview on every change to the source config`,
+	Args:              cobra.MaximumNArgs(1),
+	PersistentPreRunE: loadDefaults,
+	RunE:              runMigrate,
 }
 
-// Execute runs the root command.
+// Execute runs the root command. An interrupt or SIGTERM cancels the
+// command's context so a long detection stops cleanly.
 func Execute() error {
-	return rootCmd.Execute()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return rootCmd.ExecuteContext(ctx)
+}
+
+// Exit codes returned by the CLI. Errors not listed below exit with
+// ExitError.
+const (
+	ExitOK              = 0
+	ExitError           = 1
+	ExitNoConfig        = 2 // no release tool configuration found
+	ExitOutputExists    = 3 // the output file exists and --force was not given
+	ExitStrict          = 4 // --strict or --min-coverage found too much needing manual migration
+	ExitUnsupportedTool = 5 // unknown --tool or config file name
+	ExitDetectionFailed = 6 // a config file could not be read or parsed
+)
+
+// outputExistsError reports an output file that would be overwritten
+// without --force.
+type outputExistsError struct {
+	path string
+}
+
+func (e *outputExistsError) Error() string {
+	return e.path + " already exists. Use --force to overwrite"
+}
+
+// lowCoverageError reports a conversion below --min-coverage.
+type lowCoverageError struct {
+	percent, min int
+	unmapped     []string
+}
+
+func (e *lowCoverageError) Error() string {
+	return fmt.Sprintf("coverage %d%% is below --min-coverage %d%%; source keys not mapped: %s",
+		e.percent, e.min, strings.Join(e.unmapped, ", "))
+}
+
+// strictModeError reports the items --strict refuses to migrate.
+type strictModeError struct {
+	warnings []converter.Warning
+}
+
+func (e *strictModeError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "strict mode: %d item(s) could not be migrated automatically:", len(e.warnings))
+	for _, w := range e.warnings {
+		fmt.Fprintf(&b, "\n  - %s", w)
+	}
+	return b.String()
+}
+
+// ExitCode maps an error returned by Execute to the process exit code.
+func ExitCode(err error) int {
+	var detection *detector.DetectionError
+	var conflict *detector.ConflictError
+	var exists *outputExistsError
+	var strictErr *strictModeError
+	var coverage *lowCoverageError
+	switch {
+	case err == nil:
+		return ExitOK
+	case errors.Is(err, detector.ErrNoConfigFound):
+		return ExitNoConfig
+	case errors.As(err, &exists):
+		return ExitOutputExists
+	case errors.As(err, &strictErr), errors.As(err, &coverage):
+		return ExitStrict
+	case errors.Is(err, detector.ErrUnsupportedTool):
+		return ExitUnsupportedTool
+	case errors.As(err, &detection), errors.As(err, &conflict):
+		return ExitDetectionFailed
+	default:
+		return ExitError
+	}
 }
 
 func init() {
-	rootCmd.Flags().StringVarP(&outputFile, "output", "o", "release.config.yaml", "Output file path")
+	rootCmd.Flags().StringVarP(&outputFile, "output", "o", defaultOutput, "Output file path, or - for stdout")
+	rootCmd.Flags().StringVar(&outFormat, "format", "yaml", "Format of the generated config: yaml or json")
+	rootCmd.Flags().StringVar(&outputDir, "output-dir", "", "Directory to write the output file to (default: the project directory)")
 	rootCmd.Flags().BoolVarP(&dryRun, "dry-run", "n", false, "Preview changes without writing files")
 	rootCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose output")
 	rootCmd.Flags().BoolVarP(&force, "force", "f", false, "Overwrite existing release.config.yaml")
+	rootCmd.Flags().BoolVarP(&assumeYes, "yes", "y", false, "Assume yes: overwrite existing files (implies --force) and accept prompt defaults")
+	rootCmd.Flags().StringVar(&profile, "profile", "", "Prefer profile-specific config files (e.g. .goreleaser.<profile>.yml)")
+	rootCmd.Flags().IntVar(&minCover, "min-coverage", 0, "Fail when less than this percentage of the source config's top-level keys is mapped")
+	rootCmd.Flags().BoolVar(&strict, "strict", false, "Fail on any unmapped item or missing profile config file")
+	rootCmd.Flags().BoolVar(&failOnJS, "fail-on-js", false, "Fail when the config is a JavaScript/TypeScript file nothing could be read from statically")
+	rootCmd.Flags().StringVar(&reportFile, "report", "", "Write a JSON migration report to this path (e.g. migrate-report.json)")
+	rootCmd.Flags().StringVarP(&configFile, "config", "c", "", "Convert this config file, glob of files or http(s) URL instead of auto-detecting")
+	rootCmd.Flags().StringVar(&inputFmt, "input-format", "", "Force the --config parser: json, yaml or toml")
+	rootCmd.Flags().BoolVar(&annotate, "annotate", false, "Comment each field with where its value came from")
+	rootCmd.Flags().BoolVar(&docStart, "yaml-doc-start", false, "Begin the generated YAML with a --- document start marker")
+	rootCmd.Flags().IntVar(&indent, "indent", 0, "Spaces per indentation level in the generated config (default: 4 for YAML, 2 for JSON)")
+	rootCmd.Flags().BoolVar(&splitPlug, "split-plugins", false, "Write plugins to a separate file (e.g. release.plugins.yaml) referenced by plugins_file")
+	rootCmd.Flags().StringVar(&toolName, "tool", "", "Only consider this source tool (e.g. semantic-release, release-it)")
+	rootCmd.Flags().StringSliceVar(&prefer, "prefer", nil, "Detect these tools first when several are configured (e.g. release-it,goreleaser)")
+	rootCmd.Flags().DurationVar(&timeout, "timeout", detector.DefaultTimeout, "Timeout for fetching a --config URL or a GoReleaser from_url include")
+	rootCmd.Flags().BoolVar(&offline, "offline", false, "Do not fetch GoReleaser from_url includes; the config may be incomplete")
+	rootCmd.Flags().BoolVar(&noPushTags, "no-push-tags", false, "Generate git.push_tags: false (e.g. when CI pushes tags)")
+	rootCmd.Flags().BoolVar(&noTag, "no-create-tag", false, "Generate git.create_tag: false")
+	rootCmd.Flags().BoolVar(&allowDirty, "allow-dirty", false, "Generate git.require_clean_tree: false")
+	rootCmd.Flags().StringVar(&defBranch, "default-branch", "", "Release branch to use when the source config names none (default: read from git, else main)")
+	rootCmd.Flags().BoolVar(&normAssets, "normalize-assets", true, "Name GoReleaser asset architectures as the archive name_template does (false keeps GOARCH names like amd64)")
+	rootCmd.Flags().StringVar(&pluginMap, "plugin-map", "", "JSON file mapping source plugin names to Relicta plugins (e.g. plugins.json)")
+	rootCmd.Flags().BoolVar(&verify, "verify", false, "Run 'relicta plan --dry-run' against the written config")
+	rootCmd.Flags().BoolVar(&mergePkg, "merge-package-json", false, "After migrating config embedded in package.json, remove only its key and keep the file's formatting")
+	rootCmd.Flags().BoolVar(&explain, "explain", false, "Print a source key to Relicta field mapping table")
+	rootCmd.Flags().BoolVarP(&recursive, "recursive", "r", false, "Migrate every package below the directory (follows pnpm/yarn/npm workspaces)")
+	rootCmd.Flags().BoolVar(&searchUp, "search-up", false, "Look for the config in parent directories, up to the git work tree root")
+	rootCmd.Flags().StringVar(&since, "since", "", "Skip configs not modified after this RFC 3339 time or file's modification time (e.g. a stamp file from the last run)")
+	rootCmd.Flags().BoolVarP(&watch, "watch", "w", false, "Re-run the migration preview whenever the source config changes (never writes)")
+	rootCmd.Flags().BoolVar(&mergeTools, "merge-tools", false, "Merge the configs of every release tool found (e.g. semantic-release and GoReleaser) into one")
+	rootCmd.Flags().StringArrayVar(&mergePref, "merge-prefer", nil, "With --merge-tools, take a config section from this tool on conflicts, e.g. --merge-prefer plugins=goreleaser (repeatable)")
+	rootCmd.Flags().BoolVar(&validSrc, "validate-source", false, "Check the source config for contradictory settings before converting")
+	rootCmd.Flags().StringArrayVar(&overrides, "set", nil, "Override a generated field, e.g. --set versioning.strategy=calver (repeatable)")
+
+	detectCmd.Flags().StringVar(&profile, "profile", "", "Prefer profile-specific config files (e.g. .goreleaser.<profile>.yml)")
+	detectCmd.Flags().BoolVar(&strict, "strict", false, "Fail if the requested profile config file does not exist")
+	detectCmd.Flags().StringVarP(&configFile, "config", "c", "", "Inspect this config file or http(s) URL instead of auto-detecting")
+	detectCmd.Flags().StringVar(&inputFmt, "input-format", "", "Force the --config parser: json, yaml or toml")
+	detectCmd.Flags().BoolVar(&searchUp, "search-up", false, "Look for the config in parent directories, up to the git work tree root")
+	detectCmd.Flags().StringVar(&toolName, "tool", "", "Only consider this source tool (e.g. semantic-release, release-it)")
+	detectCmd.Flags().StringSliceVar(&prefer, "prefer", nil, "Detect these tools first when several are configured (e.g. release-it,goreleaser)")
+	detectCmd.Flags().DurationVar(&timeout, "timeout", detector.DefaultTimeout, "Timeout for fetching a --config URL")
+
+	checkCmd.Flags().StringVar(&profile, "profile", "", "Prefer profile-specific config files (e.g. .goreleaser.<profile>.yml)")
+	checkCmd.Flags().IntVar(&minCover, "min-coverage", 0, "Fail when less than this percentage of the source config's top-level keys is mapped")
+	checkCmd.Flags().BoolVar(&failOnJS, "fail-on-js", false, "Fail when the config is a JavaScript/TypeScript file nothing could be read from statically")
+	checkCmd.Flags().StringVarP(&configFile, "config", "c", "", "Check this config file or http(s) URL instead of auto-detecting")
+	checkCmd.Flags().StringVar(&inputFmt, "input-format", "", "Force the --config parser: json, yaml or toml")
+	checkCmd.Flags().BoolVar(&searchUp, "search-up", false, "Look for the config in parent directories, up to the git work tree root")
+	checkCmd.Flags().StringVar(&toolName, "tool", "", "Only consider this source tool (e.g. semantic-release, release-it)")
+	checkCmd.Flags().StringSliceVar(&prefer, "prefer", nil, "Detect these tools first when several are configured (e.g. release-it,goreleaser)")
+	checkCmd.Flags().DurationVar(&timeout, "timeout", detector.DefaultTimeout, "Timeout for fetching a --config URL or a GoReleaser from_url include")
+	checkCmd.Flags().BoolVar(&offline, "offline", false, "Do not fetch GoReleaser from_url includes; the config may be incomplete")
+	checkCmd.Flags().StringVar(&defBranch, "default-branch", "", "Release branch to use when the source config names none (default: read from git, else main)")
+	checkCmd.Flags().BoolVar(&normAssets, "normalize-assets", true, "Name GoReleaser asset architectures as the archive name_template does (false keeps GOARCH names like amd64)")
+	checkCmd.Flags().StringVar(&pluginMap, "plugin-map", "", "JSON file mapping source plugin names to Relicta plugins (e.g. plugins.json)")
+	checkCmd.Flags().BoolVar(&validSrc, "validate-source", false, "Check the source config for contradictory settings before converting")
+
+	compareCmd.Flags().StringVar(&profile, "profile", "", "Prefer profile-specific config files (e.g. .goreleaser.<profile>.yml)")
+	compareCmd.Flags().StringVarP(&configFile, "config", "c", "", "Compare this config file or http(s) URL instead of auto-detecting")
+	compareCmd.Flags().StringVar(&inputFmt, "input-format", "", "Force the --config parser: json, yaml or toml")
+	compareCmd.Flags().BoolVar(&searchUp, "search-up", false, "Look for the config in parent directories, up to the git work tree root")
+	compareCmd.Flags().StringVar(&toolName, "tool", "", "Only consider this source tool (e.g. semantic-release, release-it)")
+	compareCmd.Flags().StringSliceVar(&prefer, "prefer", nil, "Detect these tools first when several are configured (e.g. release-it,goreleaser)")
+	compareCmd.Flags().DurationVar(&timeout, "timeout", detector.DefaultTimeout, "Timeout for fetching a --config URL or a GoReleaser from_url include")
+	compareCmd.Flags().BoolVar(&offline, "offline", false, "Do not fetch GoReleaser from_url includes; the config may be incomplete")
+	compareCmd.Flags().StringVar(&defBranch, "default-branch", "", "Release branch to use when the source config names none (default: read from git, else main)")
+	compareCmd.Flags().BoolVar(&normAssets, "normalize-assets", true, "Name GoReleaser asset architectures as the archive name_template does (false keeps GOARCH names like amd64)")
+	compareCmd.Flags().StringVar(&pluginMap, "plugin-map", "", "JSON file mapping source plugin names to Relicta plugins (e.g. plugins.json)")
+
+	initCmd.Flags().StringVarP(&outputFile, "output", "o", defaultOutput, "Output file path")
+	initCmd.Flags().BoolVarP(&dryRun, "dry-run", "n", false, "Preview changes without writing files")
+	initCmd.Flags().BoolVarP(&force, "force", "f", false, "Overwrite existing release.config.yaml")
+	initCmd.Flags().BoolVarP(&assumeYes, "yes", "y", false, "Assume yes: overwrite existing files (implies --force) and accept prompt defaults")
+	initCmd.Flags().BoolVar(&docStart, "yaml-doc-start", false, "Begin the generated YAML with a --- document start marker")
+	initCmd.Flags().IntVar(&indent, "indent", 0, "Spaces per indentation level in the generated config (default: 4)")
+	initCmd.Flags().StringVar(&tagPrefix, "tag-prefix", "v", "Prefix for release tags")
+	initCmd.Flags().StringVar(&branch, "branch", "", "Branch releases are cut from (default: the git default branch, else main)")
 
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(detectCmd)
+	rootCmd.AddCommand(checkCmd)
+	rootCmd.AddCommand(compareCmd)
+	rootCmd.AddCommand(initCmd)
+	rootCmd.AddCommand(toolsCmd)
+	rootCmd.AddCommand(explainCmd)
 }
 
 var versionCmd = &cobra.Command{
@@ -71,13 +310,14 @@ var detectCmd = &cobra.Command{
 	Use:   "detect [directory]",
 	Short: "Detect which release tool is configured",
 	Args:  cobra.MaximumNArgs(1),
-	RunE: func(_ *cobra.Command, args []string) error {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		dir := "."
 		if len(args) > 0 {
 			dir = args[0]
 		}
 
-		result, err := detector.Detect(dir)
+		detectOnly = true
+		result, err := detect(cmd.Context(), dir)
 		if err != nil {
 			return err
 		}
@@ -89,6 +329,9 @@ var detectCmd = &cobra.Command{
 
 		fmt.Printf("Detected: %s\n", result.Tool)
 		fmt.Printf("Config file: %s\n", result.ConfigFile)
+		for _, w := range result.Warnings {
+			fmt.Printf("Warning: %s\n", w)
+		}
 		if verbose && len(result.Details) > 0 {
 			fmt.Println("\nDetails:")
 			for k, v := range result.Details {
@@ -99,66 +342,1123 @@ var detectCmd = &cobra.Command{
 	},
 }
 
-func runMigrate(_ *cobra.Command, args []string) error {
+var checkCmd = &cobra.Command{
+	Use:   "check [directory]",
+	Short: "Summarize how ready a project is to migrate, without writing files",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir := "."
+		if len(args) > 0 {
+			dir = args[0]
+		}
+
+		result, err := detect(cmd.Context(), dir)
+		if err != nil {
+			return fmt.Errorf("detection failed: %w", err)
+		}
+		if result.Tool == detector.ToolNone {
+			return fmt.Errorf("%w in %s", detector.ErrNoConfigFound, dir)
+		}
+		if err := jsConfigError(result); err != nil {
+			return err
+		}
+
+		conv, err := converter.ConvertDetailed(result)
+		if err != nil {
+			return fmt.Errorf("conversion failed: %w", err)
+		}
+		if validSrc {
+			conv.Warnings = append(converter.ValidateSource(result), conv.Warnings...)
+		}
+		conv.Warnings = append(conv.Warnings, consistencyWarnings(cmd.Context(), dir)...)
+
+		mapped, total := conv.Coverage()
+		keysMapped, keys := conv.SourceCoverage(result.ConfigData)
+
+		fmt.Printf("Tool:        %s\n", result.Tool)
+		fmt.Printf("Config file: %s\n", result.ConfigFile)
+		fmt.Printf("Mapped:      %d%% (%d of %d fields taken from the source config, the rest are defaults)\n", percent(mapped, total), mapped, total)
+		fmt.Printf("Coverage:    %d%% (%d of %d source keys mapped)\n", percent(keysMapped, keys), keysMapped, keys)
+
+		if len(conv.Warnings) == 0 {
+			fmt.Println("\nVerdict: ready - everything converts automatically")
+		} else {
+			fmt.Printf("\nNeeds manual attention (%d):\n", len(conv.Warnings))
+			for _, w := range conv.Warnings {
+				fmt.Printf("  - %s\n", w)
+			}
+			fmt.Println("\nVerdict: needs-work - review the items above before migrating")
+		}
+		return coverageError(conv, result.ConfigData)
+	},
+}
+
+var compareCmd = &cobra.Command{
+	Use:   "compare [directory]",
+	Short: "Compare the current tool's capabilities with what Relicta covers",
+	Long: `Compare groups the detected config by feature and shows, side by side,
+which features map cleanly to Relicta, which map with caveats and which
+have no equivalent. It writes no files.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir := "."
+		if len(args) > 0 {
+			dir = args[0]
+		}
+
+		result, err := detect(cmd.Context(), dir)
+		if err != nil {
+			return fmt.Errorf("detection failed: %w", err)
+		}
+		if result.Tool == detector.ToolNone {
+			return fmt.Errorf("%w in %s", detector.ErrNoConfigFound, dir)
+		}
+
+		conv, err := converter.ConvertDetailed(result)
+		if err != nil {
+			return fmt.Errorf("conversion failed: %w", err)
+		}
+
+		table, err := output.Compare(string(result.Tool), conv, result.ConfigData)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Config file: %s\n\n", result.ConfigFile)
+		fmt.Print(table)
+		return nil
+	},
+}
+
+var toolsCmd = &cobra.Command{
+	Use:   "tools",
+	Short: "List supported source tools, their config files and what gets converted",
+	Args:  cobra.NoArgs,
+	Run: func(_ *cobra.Command, _ []string) {
+		for i, info := range detector.ToolInfos() {
+			if i > 0 {
+				fmt.Println()
+			}
+			support := converter.SupportFor(info.Tool)
+			fmt.Println(info.Tool)
+			fmt.Printf("  Config files: %s\n", strings.Join(info.ConfigFiles, ", "))
+			fmt.Printf("  Converted:    %s\n", strings.Join(support.Converted, ", "))
+			fmt.Printf("  Manual:       %s\n", strings.Join(support.Manual, ", "))
+		}
+	},
+}
+
+var explainCmd = &cobra.Command{
+	Use:   "explain <tool>",
+	Short: "Describe how a source tool's settings map to Relicta fields",
+	Long: `Explain prints the mapping rules of a source tool without a config to
+convert. The rules come from converting a built-in example config, so they
+show exactly what migrate does with each setting: the Relicta field it
+becomes, the defaults filled in when it is missing, and what is left for
+manual migration.`,
+	Example:   "  migrate explain semantic-release",
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: toolNames(),
+	RunE: func(_ *cobra.Command, args []string) error {
+		tool := detector.Tool(args[0])
+		example, conv, err := converter.Example(tool)
+		if err != nil {
+			return fmt.Errorf("%w (supported: %s)", err, strings.Join(toolNames(), ", "))
+		}
+		table, err := output.Explain(conv, example.ConfigData)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("How migrate converts %s, shown on an example %s:\n\n", tool, example.ConfigFile)
+		fmt.Print(table)
+		printAssumptions(conv)
+		if manual := converter.SupportFor(tool).Manual; len(manual) > 0 {
+			fmt.Println("\nNeeds manual migration:")
+			for _, m := range manual {
+				fmt.Printf("  - %s\n", m)
+			}
+		}
+		return nil
+	},
+}
+
+// toolNames lists the supported source tools by name.
+func toolNames() []string {
+	var names []string
+	for _, tool := range detector.Tools() {
+		names = append(names, string(tool))
+	}
+	return names
+}
+
+var initCmd = &cobra.Command{
+	Use:   "init [directory]",
+	Short: "Write a default Relicta config for a project without a release tool",
+	Long: `Init writes a starter release.config.yaml: conventional versioning, a
+changelog and GitHub releases.
+
+When run in a terminal, init asks for the tag prefix and release branch
+unless --tag-prefix or --branch is given.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runInit,
+}
+
+func runInit(cmd *cobra.Command, args []string) error {
 	dir := "."
 	if len(args) > 0 {
 		dir = args[0]
 	}
+	if assumeYes {
+		force = true
+	}
+	if err := checkOutputFlags(); err != nil {
+		return err
+	}
 
-	// Check if output already exists
 	outputPath := filepath.Join(dir, outputFile)
 	if _, err := os.Stat(outputPath); err == nil && !force && !dryRun {
-		return fmt.Errorf("%s already exists. Use --force to overwrite", outputPath)
+		return &outputExistsError{path: outputPath}
+	}
+
+	if branch == "" {
+		branch = detector.DefaultBranch(dir)
+	}
+	if branch == "" {
+		branch = "main"
+	}
+
+	if interactive() {
+		in := bufio.NewReader(os.Stdin)
+		if !cmd.Flags().Changed("tag-prefix") {
+			tagPrefix = prompt(in, "Tag prefix", tagPrefix)
+		}
+		if !cmd.Flags().Changed("branch") {
+			branch = prompt(in, "Release branch", branch)
+		}
+	}
+
+	conv := &converter.Conversion{Config: converter.NewDefaultConfig(tagPrefix, branch)}
+
+	if dryRun {
+		yaml, err := renderConfig(outputPath, conv)
+		if err != nil {
+			return err
+		}
+		fmt.Println(yaml)
+		return nil
+	}
+
+	if err := writeConfig(outputPath, conv); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	fmt.Printf("Created %s\n", outputPath)
+	return nil
+}
+
+// interactive reports whether prompts should be shown: stdin is a
+// terminal and --yes was not given.
+func interactive() bool {
+	return !assumeYes && isTerminal(os.Stdin)
+}
+
+// isTerminal reports whether f is an interactive terminal.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// prompt asks for a value on stdout, returning def when the answer is empty.
+func prompt(in *bufio.Reader, question, def string) string {
+	fmt.Printf("%s [%s]: ", question, def)
+	answer, err := in.ReadString('\n')
+	if err != nil {
+		return def
+	}
+	if answer = strings.TrimSpace(answer); answer != "" {
+		return answer
+	}
+	return def
+}
+
+// resolveOutputPath returns where the generated config for dir is written.
+// With --output-dir the file goes there instead of into the project.
+func resolveOutputPath(dir string) string {
+	if outputFile == stdoutPath {
+		return stdoutPath
+	}
+	if outputDir != "" {
+		return filepath.Join(outputDir, outputFile)
+	}
+	return filepath.Join(dir, outputFile)
+}
+
+// loadPluginMap registers the plugin mappings from the --plugin-map file
+// before a command converts anything.
+func loadPluginMap(_ *cobra.Command, _ []string) error {
+	if pluginMap == "" {
+		return nil
+	}
+	data, err := os.ReadFile(pluginMap)
+	if err != nil {
+		return fmt.Errorf("failed to read plugin map: %w", err)
+	}
+	mappings, err := converter.ParsePluginMap(data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", pluginMap, err)
+	}
+	for source, m := range mappings {
+		converter.RegisterPluginMapping(source, m)
+	}
+	return nil
+}
+
+// detectOptions builds detector options from the command-line flags.
+func detectOptions() (detector.Options, error) {
+	opts := detector.Options{
+		Profile:       profile,
+		Strict:        strict,
+		InputFormat:   inputFmt,
+		Timeout:       timeout,
+		DefaultBranch: defBranch,
+		KeepGoarch:    !normAssets,
+		SearchUp:      searchUp,
+		Cache:         runCache,
+
+		SkipRemoteIncludes: offline || detectOnly,
+	}
+	if toolName != "" {
+		tool, err := detector.ParseTool(toolName)
+		if err != nil {
+			return opts, err
+		}
+		opts.Tool = tool
+	}
+	for _, name := range prefer {
+		tool, err := detector.ParseTool(name)
+		if err != nil {
+			return opts, fmt.Errorf("invalid --prefer: %w", err)
+		}
+		opts.Prefer = append(opts.Prefer, tool)
+	}
+	return opts, nil
+}
+
+// detect reads the file given by --config, or auto-detects in dir.
+func detect(ctx context.Context, dir string) (*detector.Result, error) {
+	opts, err := detectOptions()
+	if err != nil {
+		return nil, err
+	}
+	if configFile != "" {
+		if offline && strings.Contains(configFile, "://") {
+			return nil, fmt.Errorf("--offline cannot fetch --config %s", configFile)
+		}
+		return detector.DetectFile(configFile, opts)
+	}
+	if inputFmt != "" {
+		return nil, fmt.Errorf("--input-format requires --config")
+	}
+	return detector.DetectWithOptionsContext(ctx, dir, opts)
+}
+
+// mergeDetected converts the configs of the other release tools found in
+// dir and merges them into conv, which was converted from result. Each
+// section's conflicts are settled as --merge-prefer says, or else by the
+// tools' roles (see converter.Merge). It reports whether there was
+// anything to merge.
+func mergeDetected(ctx context.Context, dir string, result *detector.Result, conv *converter.Conversion) (*converter.Conversion, bool, error) {
+	if configFile != "" {
+		return nil, false, fmt.Errorf("--merge-tools cannot be used with --config, which names a single file")
+	}
+	prefer, err := converter.ParseMergePrefer(mergePref)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid --merge-prefer: %w", err)
+	}
+	opts, err := detectOptions()
+	if err != nil {
+		return nil, false, err
+	}
+	results, err := detector.DetectAllContext(ctx, dir, opts)
+	if err != nil {
+		return nil, false, fmt.Errorf("detection failed: %w", err)
+	}
+
+	convs := []converter.ToolConversion{{Tool: result.Tool, Conversion: conv}}
+	for _, other := range results {
+		if other.Tool == result.Tool || other.Tool == detector.ToolNone {
+			continue
+		}
+		if err := jsConfigError(other); err != nil {
+			return nil, false, err
+		}
+		otherConv, err := converter.ConvertDetailed(other)
+		if err != nil {
+			return nil, false, fmt.Errorf("conversion of %s failed: %w", other.ConfigFile, err)
+		}
+		if validSrc {
+			otherConv.Warnings = append(converter.ValidateSource(other), otherConv.Warnings...)
+		}
+		fmt.Fprintf(status, "Merging: %s (%s)\n", other.Tool, other.ConfigFile)
+		convs = append(convs, converter.ToolConversion{Tool: other.Tool, Conversion: otherConv})
+	}
+	if len(convs) == 1 {
+		return conv, false, nil
+	}
+	return converter.Merge(convs, prefer), true, nil
+}
+
+// consistencyWarnings converts every release tool config found in dir
+// and reports settings they disagree on. It is skipped for --config, which
+// names a single file.
+func consistencyWarnings(ctx context.Context, dir string) []converter.Warning {
+	if configFile != "" {
+		return nil
+	}
+	opts, err := detectOptions()
+	if err != nil {
+		return nil
+	}
+	results, err := detector.DetectAllContext(ctx, dir, opts)
+	if err != nil || len(results) < 2 {
+		return nil
+	}
+
+	sources := make([]converter.Source, 0, len(results))
+	for _, result := range results {
+		conv, err := converter.ConvertDetailed(result)
+		if err != nil {
+			continue
+		}
+		sources = append(sources, converter.Source{File: result.ConfigFile, Config: conv.Config, Provenance: conv.Provenance})
+	}
+	return converter.CheckConsistency(sources)
+}
+
+func runMigrate(cmd *cobra.Command, args []string) error {
+	dir := "."
+	if len(args) > 0 {
+		dir = args[0]
+	}
+	if assumeYes {
+		force = true
+	}
+	if err := checkOutputFlags(); err != nil {
+		return err
+	}
+	if outputFile == stdoutPath {
+		status = os.Stderr
+		defer func() { status = os.Stdout }()
+	}
+	if since != "" {
+		t, err := parseSince(since)
+		if err != nil {
+			return err
+		}
+		sinceTime = t
+	}
+
+	if watch {
+		if isGlob(configFile) || recursive {
+			return errors.New("--watch cannot be combined with a --config glob or --recursive")
+		}
+		return runWatch(cmd.Context(), dir)
+	}
+	if isGlob(configFile) {
+		return runBatch(configFile)
+	}
+	if recursive {
+		return runRecursive(cmd.Context(), dir)
+	}
+
+	// Check if output already exists. With --since an unchanged config
+	// is skipped before its existing output is an error, and with
+	// --search-up the output goes where the config is found.
+	outputPath := resolveOutputPath(dir)
+	lateCheck := !sinceTime.IsZero() || searchUp
+	if _, err := os.Stat(outputPath); err == nil && !force && !dryRun && !lateCheck && outputPath != stdoutPath {
+		return &outputExistsError{path: outputPath}
 	}
 
 	// Detect tool
 	if verbose {
-		fmt.Println("Detecting release tool configuration...")
+		fmt.Fprintln(status, "Detecting release tool configuration...")
 	}
 
-	result, err := detector.Detect(dir)
+	result, err := detect(cmd.Context(), dir)
 	if err != nil {
 		return fmt.Errorf("detection failed: %w", err)
 	}
 
 	if result.Tool == detector.ToolNone {
-		return fmt.Errorf("no release tool configuration found in %s", dir)
+		return fmt.Errorf("%w in %s", detector.ErrNoConfigFound, dir)
 	}
 
-	fmt.Printf("Detected: %s (%s)\n", result.Tool, result.ConfigFile)
+	fmt.Fprintf(status, "Detected: %s (%s)\n", result.Tool, result.ConfigFile)
+
+	if found, ok := result.Details["dir"].(string); ok {
+		dir = found
+		outputPath = resolveOutputPath(dir)
+	}
+	if !sinceTime.IsZero() && unchangedSince(result, sinceTime) {
+		fmt.Fprintf(status, "%s unchanged since %s, skipping\n", result.ConfigFile, sinceTime.Format(time.RFC3339))
+		return nil
+	}
+	if _, err := os.Stat(outputPath); err == nil && !force && !dryRun && lateCheck && outputPath != stdoutPath {
+		return &outputExistsError{path: outputPath}
+	}
+	if err := jsConfigError(result); err != nil {
+		return err
+	}
 
 	// Convert configuration
 	if verbose {
-		fmt.Println("Converting configuration...")
+		fmt.Fprintln(status, "Converting configuration...")
 	}
 
-	config, err := converter.Convert(result)
+	conv, err := converter.ConvertDetailed(result)
 	if err != nil {
 		return fmt.Errorf("conversion failed: %w", err)
 	}
+	if validSrc {
+		conv.Warnings = append(converter.ValidateSource(result), conv.Warnings...)
+	}
+	merged := false
+	if len(mergePref) > 0 && !mergeTools {
+		return errors.New("--merge-prefer requires --merge-tools")
+	}
+	if mergeTools {
+		if conv, merged, err = mergeDetected(cmd.Context(), dir, result, conv); err != nil {
+			return err
+		}
+	}
+
+	if err := applyOverrides(conv); err != nil {
+		return err
+	}
+	// Merging already reports the fields the tools disagree on
+	if !merged {
+		conv.Warnings = append(conv.Warnings, consistencyWarnings(cmd.Context(), dir)...)
+	}
+
+	if len(conv.Warnings) > 0 {
+		fmt.Fprintln(status, "\nWarnings:")
+		for _, w := range conv.Warnings {
+			fmt.Fprintf(status, "  - %s\n", w)
+		}
+	}
+	if verbose {
+		printAssumptions(conv)
+	}
+
+	report := output.NewReport(string(result.Tool), result.ConfigFile, outputPath, conv)
+	report.DryRun = dryRun
+
+	// A --strict or --min-coverage failure stops a real run before
+	// anything is written. A dry run still shows the preview and fails
+	// afterwards, so CI can see what a clean migration is missing.
+	var gateErr error
+	if strict && len(conv.Warnings) > 0 {
+		gateErr = strictError(conv.Warnings)
+	} else {
+		gateErr = coverageError(conv, result.ConfigData)
+	}
+	if gateErr != nil {
+		report.Error = gateErr.Error()
+		if !dryRun {
+			if reportErr := writeReport(report); reportErr != nil {
+				fmt.Fprintln(os.Stderr, reportErr)
+			}
+			return gateErr
+		}
+	}
+
+	if explain {
+		table, err := output.Explain(conv, result.ConfigData)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(status, "\n--- Field mapping ---")
+		fmt.Fprint(status, table)
+	}
 
 	// Output
 	if dryRun {
-		fmt.Println("\n--- Generated release.config.yaml (dry-run) ---")
-		yaml, err := output.ToYAML(config)
+		fmt.Fprintln(status, "\n--- Generated release.config.yaml (dry-run) ---")
+		yaml, err := renderConfig(outputPath, conv)
 		if err != nil {
 			return err
 		}
 		fmt.Println(yaml)
-		fmt.Println("--- End of preview ---")
-		return nil
+		fmt.Fprintln(status, "--- End of preview ---")
+		if path, key, ok := detector.PackageJSONSource(result); ok && mergePkg {
+			fmt.Fprintf(status, "Would remove the %q key from %s\n", key, path)
+		}
+		if err := writeReport(report); err != nil {
+			return err
+		}
+		return gateErr
+	}
+
+	if outputPath == stdoutPath {
+		content, err := renderConfig(outputPath, conv)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(os.Stdout, content); err != nil {
+			return fmt.Errorf("failed to write config: %w", err)
+		}
+		report.Written = true
+		return writeReport(report)
 	}
 
 	// Write file
-	if err := output.WriteYAML(outputPath, config); err != nil {
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	if err := writeConfig(outputPath, conv); err != nil {
+		report.Error = err.Error()
+		if reportErr := writeReport(report); reportErr != nil {
+			fmt.Fprintln(os.Stderr, reportErr)
+		}
 		return fmt.Errorf("failed to write config: %w", err)
 	}
+	report.Written = true
+
+	if err := writeReport(report); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(status, "\nSuccessfully created %s\n", outputPath)
+	if _, plugins := splitPlugins(outputPath, conv); plugins != "" {
+		fmt.Fprintf(status, "Successfully created %s\n", plugins)
+	}
+
+	if mergePkg {
+		if err := stripPackageJSON(result); err != nil {
+			return err
+		}
+	}
+
+	if verify {
+		return verifyConfig(cmd.Context(), dir)
+	}
+
+	fmt.Fprintln(status, "\nNext steps:")
+	fmt.Fprintln(status, "  1. Review the generated configuration")
+	fmt.Fprintln(status, "  2. Run 'relicta plan --dry-run' to test")
+	fmt.Fprintln(status, "  3. Remove old configuration files when ready")
+
+	return nil
+}
+
+// printAssumptions lists the fields that hold defaults rather than values
+// read from the source config.
+func printAssumptions(conv *converter.Conversion) {
+	assumptions := conv.Assumptions()
+	if len(assumptions) == 0 {
+		return
+	}
+	fmt.Fprintln(status, "\nAssumed defaults (verify these):")
+	for _, a := range assumptions {
+		if a.Value == nil {
+			fmt.Fprintf(status, "  - %s (%s)\n", a.Field, a.Note)
+			continue
+		}
+		fmt.Fprintf(status, "  - %s = %v (%s)\n", a.Field, a.Value, a.Note)
+	}
+}
+
+// parseSince reads a --since value: an RFC 3339 time, a date, or a file
+// whose modification time is used.
+func parseSince(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	info, err := os.Stat(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid --since %q: not a time (e.g. 2024-05-01T12:00:00Z) or an existing file", value)
+	}
+	return info.ModTime(), nil
+}
+
+// unchangedSince reports whether none of the local files a result was read
+// from was modified after t. Remote configs always count as changed.
+func unchangedSince(result *detector.Result, t time.Time) bool {
+	files := sourceFiles(result)
+	if len(files) == 0 {
+		return false
+	}
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil || info.ModTime().After(t) {
+			return false
+		}
+	}
+	return true
+}
+
+// isGlob reports whether a --config value is a file glob rather than a
+// single file or URL.
+func isGlob(pattern string) bool {
+	return !strings.Contains(pattern, "://") && strings.ContainsAny(pattern, "*?[")
+}
+
+// batchOutputPath names the output for one file of a batch after its
+// source: api.releaserc.json becomes api.release.config.yaml. Files with
+// no name before the first dot (.goreleaser.yaml) are named after their
+// directory instead.
+func batchOutputPath(source string) string {
+	dir := filepath.Dir(source)
+	stem, _, _ := strings.Cut(filepath.Base(source), ".")
+	if stem == "" {
+		stem = filepath.Base(dir)
+	}
+	if outputDir != "" {
+		dir = outputDir
+	}
+	return filepath.Join(dir, stem+"."+filepath.Base(outputFile))
+}
+
+// batchEntry is one row of the --config glob summary.
+type batchEntry struct {
+	file     string
+	tool     detector.Tool
+	output   string
+	warnings int
+	status   string
+	failed   bool
+}
+
+// runBatch converts every file matching a --config glob, inferring the tool
+// per file, and prints a per-file summary. A failing file does not stop
+// the others.
+func runBatch(pattern string) error {
+	if reportFile != "" || verify || explain {
+		return fmt.Errorf("--report, --verify and --explain cannot be used with a --config glob")
+	}
+
+	files, err := filepath.Glob(pattern)
+	if err != nil {
+		return fmt.Errorf("invalid --config glob %q: %w", pattern, err)
+	}
+	// Skip outputs of an earlier run that the glob also matches
+	suffix := "." + filepath.Base(outputFile)
+	sources := files[:0]
+	for _, file := range files {
+		if !strings.HasSuffix(file, suffix) {
+			sources = append(sources, file)
+		}
+	}
+	files = sources
+	if len(files) == 0 {
+		return fmt.Errorf("no files match %s", pattern)
+	}
+
+	opts, err := detectOptions()
+	if err != nil {
+		return err
+	}
+
+	entries := make([]batchEntry, 0, len(files))
+	written := make(map[string]string)
+	for _, file := range files {
+		entries = append(entries, migrateBatchFile(file, opts, written))
+	}
+	return printBatchSummary(entries)
+}
+
+// printBatchSummary prints one row per entry of a batch and fails if any
+// entry did.
+func printBatchSummary(entries []batchEntry) error {
+	failed := 0
+	for _, e := range entries {
+		if e.failed {
+			failed++
+		}
+	}
+
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "FILE\tTOOL\tOUTPUT\tWARNINGS\tSTATUS")
+	for _, e := range entries {
+		tool, output := string(e.tool), e.output
+		if tool == "" {
+			tool = "-"
+		}
+		if output == "" {
+			output = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n", e.file, tool, output, e.warnings, e.status)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d file(s) failed to migrate", failed, len(entries))
+	}
+	return nil
+}
+
+// runRecursive converts the config of every package found below dir,
+// writing each package's output next to it, and prints the same summary
+// as a --config glob.
+func runRecursive(ctx context.Context, dir string) error {
+	if configFile != "" {
+		return fmt.Errorf("--recursive cannot be used with --config")
+	}
+	if reportFile != "" || verify || explain {
+		return fmt.Errorf("--report, --verify and --explain cannot be used with --recursive")
+	}
+
+	opts, err := detectOptions()
+	if err != nil {
+		return err
+	}
+	packages, err := detector.DetectRecursive(ctx, dir, opts)
+	if err != nil {
+		return fmt.Errorf("detection failed: %w", err)
+	}
+	if len(packages) == 0 {
+		return fmt.Errorf("%w in %s", detector.ErrNoConfigFound, dir)
+	}
+
+	entries := make([]batchEntry, 0, len(packages))
+	written := make(map[string]string)
+	for _, pkg := range packages {
+		outputPath := filepath.Join(pkg.Dir, outputFile)
+		if outputDir != "" {
+			rel, err := filepath.Rel(dir, pkg.Dir)
+			if err != nil {
+				return err
+			}
+			outputPath = filepath.Join(outputDir, rel, outputFile)
+		}
+		entry := batchEntry{file: pkg.Result.ConfigFile}
+		entries = append(entries, migrateBatchResult(entry, pkg.Result, outputPath, written))
+	}
+	return printBatchSummary(entries)
+}
+
+// migrateBatchFile converts and writes one file of a batch. written maps
+// each output path already produced to its source so two sources never
+// write the same file.
+func migrateBatchFile(file string, opts detector.Options, written map[string]string) batchEntry {
+	entry := batchEntry{file: file}
+	fail := func(err error) batchEntry {
+		entry.status = "failed: " + err.Error()
+		entry.failed = true
+		return entry
+	}
+
+	result, err := detector.DetectFile(file, opts)
+	if err != nil {
+		return fail(err)
+	}
+	if result.Tool == detector.ToolNone {
+		return fail(detector.ErrNoConfigFound)
+	}
+	return migrateBatchResult(entry, result, batchOutputPath(file), written)
+}
+
+// migrateBatchResult converts a detected config of a batch and writes it
+// to outputPath.
+func migrateBatchResult(entry batchEntry, result *detector.Result, outputPath string, written map[string]string) batchEntry {
+	file := entry.file
+	fail := func(err error) batchEntry {
+		entry.status = "failed: " + err.Error()
+		entry.failed = true
+		return entry
+	}
+	entry.tool = result.Tool
+	if !sinceTime.IsZero() && unchangedSince(result, sinceTime) {
+		entry.status = "unchanged"
+		return entry
+	}
+	if err := jsConfigError(result); err != nil {
+		return fail(err)
+	}
+
+	conv, err := converter.ConvertDetailed(result)
+	if err != nil {
+		return fail(err)
+	}
+	if err := applyOverrides(conv); err != nil {
+		return fail(err)
+	}
+	entry.warnings = len(conv.Warnings)
+	if strict && len(conv.Warnings) > 0 {
+		return fail(fmt.Errorf("strict mode: %d item(s) could not be migrated automatically", len(conv.Warnings)))
+	}
+	if err := coverageError(conv, result.ConfigData); err != nil {
+		return fail(err)
+	}
+
+	entry.output = outputPath
+	if other, ok := written[entry.output]; ok {
+		return fail(fmt.Errorf("%s is already written for %s", entry.output, other))
+	}
+	written[entry.output] = file
+
+	if verbose {
+		for _, w := range conv.Warnings {
+			fmt.Printf("%s: %s\n", file, w)
+		}
+	}
+
+	if dryRun {
+		yaml, err := renderConfig(entry.output, conv)
+		if err != nil {
+			return fail(err)
+		}
+		fmt.Printf("--- %s (dry-run, from %s) ---\n%s\n", entry.output, file, yaml)
+		entry.status = "dry-run"
+		return entry
+	}
+
+	if _, err := os.Stat(entry.output); err == nil && !force {
+		return fail(fmt.Errorf("%s already exists, use --force to overwrite", entry.output))
+	}
+	if err := os.MkdirAll(filepath.Dir(entry.output), 0755); err != nil {
+		return fail(fmt.Errorf("failed to create output directory: %w", err))
+	}
+	if err := writeConfig(entry.output, conv); err != nil {
+		return fail(fmt.Errorf("failed to write config: %w", err))
+	}
+	entry.status = "written"
+	return entry
+}
+
+// stripPackageJSON removes the migrated tool's key from package.json for
+// --merge-package-json, leaving the rest of the file byte for byte.
+func stripPackageJSON(result *detector.Result) error {
+	path, key, ok := detector.PackageJSONSource(result)
+	if !ok {
+		fmt.Println("Nothing to merge: the config was not read from package.json")
+		return nil
+	}
+	removed, err := output.RemoveJSONFileKey(path, key)
+	if err != nil {
+		return fmt.Errorf("failed to update %s: %w", path, err)
+	}
+	if removed {
+		fmt.Printf("Removed the %q key from %s\n", key, path)
+	}
+	return nil
+}
+
+// verifyConfig runs 'relicta plan --dry-run' in the project directory
+// and surfaces its output when it fails.
+func verifyConfig(ctx context.Context, dir string) error {
+	relicta, err := exec.LookPath("relicta")
+	if err != nil {
+		fmt.Println("\nSkipping verification: relicta is not on PATH")
+		return nil
+	}
+
+	fmt.Println("\nVerifying with 'relicta plan --dry-run'...")
+	plan := exec.CommandContext(ctx, relicta, "plan", "--dry-run")
+	plan.Dir = dir
+	out, err := plan.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("verification failed: relicta plan --dry-run: %w\n%s", err, out)
+	}
+	if verbose {
+		fmt.Print(string(out))
+	}
+	fmt.Println("Verification succeeded")
+	return nil
+}
+
+// applyOverrides applies the git default flags and then the --set
+// key=value flags to the converted config, so --set has the last word.
+func applyOverrides(conv *converter.Conversion) error {
+	gitFlags := []struct {
+		set   bool
+		field string
+	}{
+		{noPushTags, "git.push_tags"},
+		{noTag, "git.create_tag"},
+		{allowDirty, "git.require_clean_tree"},
+	}
+	for _, f := range gitFlags {
+		if f.set {
+			if err := conv.Set(f.field, "false"); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, override := range overrides {
+		key, value, ok := strings.Cut(override, "=")
+		if !ok {
+			return fmt.Errorf("invalid --set %q: want key=value", override)
+		}
+		if err := conv.Set(strings.TrimSpace(key), value); err != nil {
+			return fmt.Errorf("invalid --set %q: %w", override, err)
+		}
+	}
+	return nil
+}
+
+// percent returns mapped as a whole percentage of total, or 100 when
+// total is zero.
+func percent(mapped, total int) int {
+	if total == 0 {
+		return 100
+	}
+	return mapped * 100 / total
+}
 
-	fmt.Printf("\nSuccessfully created %s\n", outputPath)
-	fmt.Println("\nNext steps:")
-	fmt.Println("  1. Review the generated configuration")
-	fmt.Println("  2. Run 'relicta plan --dry-run' to test")
-	fmt.Println("  3. Remove old configuration files when ready")
+// jsConfigError returns the --fail-on-js failure when the detected config
+// is a JavaScript or TypeScript file that no static object literal could
+// be read from, so the conversion would hold defaults only.
+func jsConfigError(result *detector.Result) error {
+	if !failOnJS {
+		return nil
+	}
+	if isJS, _ := result.ConfigData["_jsConfig"].(bool); !isJS {
+		return nil
+	}
+	reason, _ := result.ConfigData["_jsError"].(string)
+	return &detector.DetectionError{
+		Op:   "parse",
+		Path: result.ConfigFile,
+		Err:  fmt.Errorf("%s (--fail-on-js); export a static object literal or move the config to JSON or YAML", reason),
+	}
+}
 
+// coverageError returns a *lowCoverageError when a smaller share of the
+// source config's keys was mapped than --min-coverage requires.
+func coverageError(conv *converter.Conversion, source map[string]any) error {
+	if minCover <= 0 {
+		return nil
+	}
+	mapped, total := conv.SourceCoverage(source)
+	if p := percent(mapped, total); p < minCover {
+		return &lowCoverageError{percent: p, min: minCover, unmapped: conv.UnmappedKeys(source)}
+	}
+	return nil
+}
+
+// strictError builds the --strict failure listing every unmapped item.
+func strictError(warnings []converter.Warning) error {
+	return &strictModeError{warnings: warnings}
+}
+
+// yamlOptions returns the YAML output options set by --annotate,
+// --yaml-doc-start and --indent.
+func yamlOptions() output.YAMLOptions {
+	return output.YAMLOptions{Comments: annotate, DocumentStart: docStart, Indent: indent}
+}
+
+// checkOutputFlags rejects --indent and --format values and combinations
+// with --output - that cannot be honored.
+func checkOutputFlags() error {
+	if indent < 0 {
+		return fmt.Errorf("invalid --indent %d: must not be negative", indent)
+	}
+	switch output.Format(outFormat) {
+	case output.FormatYAML, "":
+	case output.FormatJSON:
+		if annotate || docStart || splitPlug {
+			return errors.New("--annotate, --yaml-doc-start and --split-plugins require --format yaml")
+		}
+	default:
+		return fmt.Errorf("unsupported --format %q (want yaml or json)", outFormat)
+	}
+
+	// relicta plan reads release.config.yaml from the directory it runs
+	// in, so --verify only checks a config written there.
+	if verify && outputFile != stdoutPath && (outputDir != "" || filepath.Clean(outputFile) != defaultOutput) {
+		return fmt.Errorf("--verify requires the config to be written to %s in the project directory, not --output-dir or another -o path", defaultOutput)
+	}
+
+	if outputFile != stdoutPath {
+		return nil
+	}
+	if watch || recursive || isGlob(configFile) {
+		return errors.New("--output - cannot be combined with --watch, --recursive or a --config glob")
+	}
+	if outputDir != "" || splitPlug || verify || mergePkg {
+		return errors.New("--output - cannot be combined with --output-dir, --split-plugins, --verify or --merge-package-json")
+	}
+	return nil
+}
+
+// renderConfig renders the converted config to be written to path as the
+// output flags describe. With --split-plugins the plugins file follows
+// the main config under its own heading.
+func renderConfig(path string, conv *converter.Conversion) (string, error) {
+	if output.Format(outFormat) == output.FormatJSON {
+		return output.ToJSONIndent(conv.Config, indent)
+	}
+	main, plugins := splitPlugins(path, conv)
+	yaml, err := output.ToYAMLWithOptions(main, yamlOptions())
+	if err != nil || plugins == "" {
+		return yaml, err
+	}
+	pluginsYAML, err := output.ToPluginsYAML(conv, yamlOptions())
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s\n--- %s ---\n%s", yaml, plugins, pluginsYAML), nil
+}
+
+// writeConfig writes the converted config to path as the output flags
+// describe, and with --split-plugins the plugins file next to it.
+func writeConfig(path string, conv *converter.Conversion) error {
+	if output.Format(outFormat) == output.FormatJSON {
+		content, err := output.ToJSONIndent(conv.Config, indent)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(path, []byte(content), 0644)
+	}
+	main, plugins := splitPlugins(path, conv)
+	if plugins != "" {
+		if err := output.WritePluginsYAML(plugins, conv, yamlOptions()); err != nil {
+			return err
+		}
+	}
+	return output.WriteYAMLWithOptions(path, main, yamlOptions())
+}
+
+// splitPlugins applies --split-plugins to the config written to path. It
+// returns the conversion to write there and the path of the plugins file,
+// or conv and "" when the plugins stay inline.
+func splitPlugins(path string, conv *converter.Conversion) (*converter.Conversion, string) {
+	if !splitPlug || len(conv.Config.Plugins) == 0 {
+		return conv, ""
+	}
+	plugins := pluginsPath(path)
+	return output.SplitPlugins(conv, filepath.Base(plugins)), plugins
+}
+
+// pluginsPath names the plugins file for the config at path:
+// release.config.yaml becomes release.plugins.yaml and any other name
+// gets .plugins before its extension.
+func pluginsPath(path string) string {
+	dir, base := filepath.Split(path)
+	if i := strings.LastIndex(base, ".config."); i >= 0 {
+		return dir + base[:i] + ".plugins." + base[i+len(".config."):]
+	}
+	ext := filepath.Ext(base)
+	return dir + strings.TrimSuffix(base, ext) + ".plugins" + ext
+}
+
+// writeReport writes the migration report when --report is set.
+func writeReport(report *output.Report) error {
+	if reportFile == "" {
+		return nil
+	}
+	if err := output.WriteReport(reportFile, report); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	if verbose {
+		fmt.Fprintf(status, "Wrote migration report to %s\n", reportFile)
+	}
 	return nil
 }
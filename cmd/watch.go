@@ -0,0 +1,244 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/relicta-tech/migrate/internal/converter"
+	"github.com/relicta-tech/migrate/internal/detector"
+)
+
+// watchDebounce is how long the source files must stay unchanged before
+// the migration is re-run, so editors that save in several writes cause a
+// single run.
+const watchDebounce = 500 * time.Millisecond
+
+// watchDirs subscribes w to the directories holding files, rather than
+// to the files themselves, so a save that replaces a file by renaming
+// over it is still seen, and drops the directories no longer needed. It
+// returns the absolute paths of files, whose events are the ones that
+// count.
+func watchDirs(w *fsnotify.Watcher, files []string) (map[string]bool, error) {
+	watched := make(map[string]bool, len(files))
+	dirs := make(map[string]bool)
+	for _, file := range files {
+		abs, err := filepath.Abs(file)
+		if err != nil {
+			return nil, err
+		}
+		watched[abs] = true
+		dirs[filepath.Dir(abs)] = true
+	}
+	for _, dir := range w.WatchList() {
+		if !dirs[dir] {
+			_ = w.Remove(dir)
+		}
+	}
+	for dir := range dirs {
+		if err := w.Add(dir); err != nil {
+			return nil, fmt.Errorf("watch %s: %w", dir, err)
+		}
+	}
+	return watched, nil
+}
+
+// sourceFiles lists the local files a detection result was read from:
+// the config file, any GoReleaser includes and the Changesets pre.json.
+// URLs are skipped.
+func sourceFiles(result *detector.Result) []string {
+	var files []string
+	add := func(file string) {
+		if file != "" && !strings.Contains(file, "://") {
+			files = append(files, file)
+		}
+	}
+	if path, _, ok := detector.PackageJSONSource(result); ok {
+		add(path)
+	} else if i := strings.LastIndex(result.ConfigFile, " ("); i > 0 && strings.HasSuffix(result.ConfigFile, ")") {
+		add(result.ConfigFile[:i])
+	} else {
+		add(result.ConfigFile)
+	}
+	included, _ := result.Details["includes"].([]string)
+	for _, file := range included {
+		add(file)
+	}
+	if pre, ok := result.Details["preFile"].(string); ok {
+		add(pre)
+	}
+	return files
+}
+
+// preview detects and converts the config in dir and renders the
+// release.config.yaml it would produce, along with the warnings.
+func preview(ctx context.Context, dir string) (*detector.Result, string, []converter.Warning, error) {
+	runCache = detector.NewCache()
+	result, err := detect(ctx, dir)
+	if err != nil {
+		return nil, "", nil, fmt.Errorf("detection failed: %w", err)
+	}
+	if result.Tool == detector.ToolNone {
+		return nil, "", nil, fmt.Errorf("%w in %s", detector.ErrNoConfigFound, dir)
+	}
+	conv, err := converter.ConvertDetailed(result)
+	if err != nil {
+		return result, "", nil, fmt.Errorf("conversion failed: %w", err)
+	}
+	if err := applyOverrides(conv); err != nil {
+		return result, "", nil, err
+	}
+	conv.Warnings = append(conv.Warnings, consistencyWarnings(ctx, dir)...)
+	yaml, err := renderConfig(resolveOutputPath(dir), conv)
+	if err != nil {
+		return result, "", nil, err
+	}
+	return result, yaml, conv.Warnings, nil
+}
+
+// runWatch previews the migration of dir, then re-runs it whenever a
+// source file changes and prints what changed in the generated config.
+// Nothing is written. It returns when ctx is cancelled, e.g. by Ctrl-C.
+func runWatch(ctx context.Context, dir string) error {
+	result, previous, warnings, err := preview(ctx, dir)
+	if err != nil {
+		return err
+	}
+	files := sourceFiles(result)
+	if len(files) == 0 {
+		return errors.New("--watch needs a local config file; " + result.ConfigFile + " cannot be watched")
+	}
+
+	fmt.Printf("Detected: %s (%s)\n", result.Tool, result.ConfigFile)
+	printWarnings(warnings)
+	fmt.Println("\n--- Generated release.config.yaml (preview) ---")
+	fmt.Println(previous)
+	fmt.Println("--- End of preview ---")
+	fmt.Printf("\nWatching %s for changes (Ctrl-C to stop)...\n", strings.Join(files, ", "))
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("--watch: %w", err)
+	}
+	defer watcher.Close()
+	watched, err := watchDirs(watcher, files)
+	if err != nil {
+		return err
+	}
+
+	debounce := time.NewTimer(watchDebounce)
+	debounce.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			fmt.Println()
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if watched[filepath.Clean(event.Name)] && !event.Has(fsnotify.Chmod) {
+				debounce.Reset(watchDebounce)
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		case now := <-debounce.C:
+			fmt.Printf("\n[%s] Change detected, re-running migration...\n", now.Format("15:04:05"))
+			result, next, warnings, err := preview(ctx, dir)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				continue
+			}
+			printWarnings(warnings)
+			if diff := lineDiff(previous, next); diff == "" {
+				fmt.Println("No changes to the generated config.")
+			} else {
+				fmt.Println("\n--- Changes to release.config.yaml ---")
+				fmt.Print(diff)
+				fmt.Println("--- End of changes ---")
+			}
+			previous = next
+			if updated := sourceFiles(result); !equalStrings(updated, files) {
+				files = updated
+				if watched, err = watchDirs(watcher, files); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// printWarnings prints conversion warnings the way runMigrate does.
+func printWarnings(warnings []converter.Warning) {
+	if len(warnings) == 0 {
+		return
+	}
+	fmt.Println("\nWarnings:")
+	for _, w := range warnings {
+		fmt.Printf("  - %s\n", w)
+	}
+}
+
+// equalStrings reports whether a and b hold the same strings in order.
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// lineDiff returns the lines removed from a ("- ") and added in b ("+ "),
+// in order, or "" when a and b are equal.
+func lineDiff(a, b string) string {
+	if a == b {
+		return ""
+	}
+	x := strings.Split(strings.TrimSuffix(a, "\n"), "\n")
+	y := strings.Split(strings.TrimSuffix(b, "\n"), "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of x[i:]
+	// and y[j:].
+	lcs := make([][]int, len(x)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(y)+1)
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var out strings.Builder
+	i, j := 0, 0
+	for i < len(x) || j < len(y) {
+		switch {
+		case i < len(x) && j < len(y) && x[i] == y[j]:
+			i++
+			j++
+		case j < len(y) && (i == len(x) || lcs[i][j+1] >= lcs[i+1][j]):
+			fmt.Fprintf(&out, "+ %s\n", y[j])
+			j++
+		default:
+			fmt.Fprintf(&out, "- %s\n", x[i])
+			i++
+		}
+	}
+	return out.String()
+}
@@ -0,0 +1,110 @@
+package converter
+
+import (
+	"regexp"
+	"strings"
+)
+
+// goDatePattern matches a date formatted inside a Go template, as in
+// {{ .Now.Format "2006.01.02" }} or {{ time "2006.01.02" }}.
+var goDatePattern = regexp.MustCompile(`(?:\.Now\.Format|\btime)\s+"([^"]+)"`)
+
+// dateCommandPattern matches a date(1) call in a shell script, as in
+// echo $(date +%Y.%m.%d).
+var dateCommandPattern = regexp.MustCompile(`\bdate\s+['"]?\+([^'"\s)]+)`)
+
+// goLayoutTokens maps Go reference time layout elements to CalVer format
+// tokens, longest first so 2006 is not read as 2 and 006.
+var goLayoutTokens = []struct {
+	layout string
+	calver string
+}{
+	{"2006", "YYYY"},
+	{"06", "YY"},
+	{"01", "0M"},
+	{"02", "0D"},
+	{"1", "MM"},
+	{"2", "DD"},
+}
+
+// strftimeTokens maps strftime conversions to CalVer format tokens.
+var strftimeTokens = map[string]string{
+	"%Y":  "YYYY",
+	"%y":  "YY",
+	"%m":  "0M",
+	"%-m": "MM",
+	"%d":  "0D",
+	"%-d": "DD",
+	"%V":  "0W",
+	"%U":  "0W",
+}
+
+// calVerFromTemplate returns the CalVer format of a date-based Go version
+// template, e.g. "YYYY.0M.0D" for {{ .Now.Format "2006.01.02" }}.
+func calVerFromTemplate(template string) (string, bool) {
+	m := goDatePattern.FindStringSubmatch(template)
+	if m == nil {
+		return "", false
+	}
+
+	var b strings.Builder
+	layout := m[1]
+	for layout != "" {
+		matched := false
+		for _, t := range goLayoutTokens {
+			if rest, ok := strings.CutPrefix(layout, t.layout); ok {
+				b.WriteString(t.calver)
+				layout = rest
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			b.WriteByte(layout[0])
+			layout = layout[1:]
+		}
+	}
+	return checkCalVer(b.String())
+}
+
+// calVerFromScript returns the CalVer format of a script that computes the
+// version with date(1), e.g. "YYYY.0M.0D" for date +%Y.%m.%d.
+func calVerFromScript(script string) (string, bool) {
+	m := dateCommandPattern.FindStringSubmatch(script)
+	if m == nil {
+		return "", false
+	}
+
+	var b strings.Builder
+	format := m[1]
+	for format != "" {
+		if format[0] != '%' {
+			b.WriteByte(format[0])
+			format = format[1:]
+			continue
+		}
+		n := 2
+		if strings.HasPrefix(format, "%-") {
+			n = 3
+		}
+		if len(format) < n {
+			return "", false
+		}
+		token, ok := strftimeTokens[format[:n]]
+		if !ok {
+			return "", false
+		}
+		b.WriteString(token)
+		format = format[n:]
+	}
+	return checkCalVer(b.String())
+}
+
+// checkCalVer accepts a CalVer format only when it contains a year, since
+// a bare day or month is not a usable version.
+func checkCalVer(format string) (string, bool) {
+	if !strings.Contains(format, "YY") {
+		return "", false
+	}
+	return format, true
+}
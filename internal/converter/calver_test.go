@@ -0,0 +1,97 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/relicta-tech/migrate/internal/detector"
+)
+
+func TestCalVerFromTemplate(t *testing.T) {
+	tests := []struct {
+		template string
+		want     string
+		wantOK   bool
+	}{
+		{`{{ .Now.Format "2006.01.02" }}`, "YYYY.0M.0D", true},
+		{`{{.Now.Format "06.1.2"}}-{{ .ShortCommit }}`, "YY.MM.DD", true},
+		{`{{ time "2006.01" }}.{{ .Env.BUILD }}`, "YYYY.0M", true},
+		{`{{ incpatch .Version }}-next`, "", false},
+		{`{{ .Now.Format "01.02" }}`, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			got, ok := calVerFromTemplate(tt.template)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("calVerFromTemplate() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCalVerFromScript(t *testing.T) {
+	tests := []struct {
+		script string
+		want   string
+		wantOK bool
+	}{
+		{`echo $(date +%Y.%m.%d)`, "YYYY.0M.0D", true},
+		{`date '+%y.%-m.%-d'`, "YY.MM.DD", true},
+		{`date +%Y.%V`, "YYYY.0W", true},
+		{`date +%H%M`, "", false},
+		{`node scripts/next-version.js`, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.script, func(t *testing.T) {
+			got, ok := calVerFromScript(tt.script)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("calVerFromScript() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestConvert_CalVer(t *testing.T) {
+	tests := []struct {
+		name   string
+		result *detector.Result
+		want   string
+	}{
+		{
+			name: "goreleaser snapshot template",
+			result: &detector.Result{
+				Tool: detector.ToolGoReleaser,
+				ConfigData: map[string]any{
+					"snapshot": map[string]any{"version_template": `{{ .Now.Format "2006.01.02" }}`},
+				},
+			},
+			want: "YYYY.0M.0D",
+		},
+		{
+			name: "standard-version prebump script",
+			result: &detector.Result{
+				Tool: detector.ToolStandardVersion,
+				ConfigData: map[string]any{
+					"scripts": map[string]any{"prebump": "echo $(date +%Y.%m.%d)"},
+				},
+			},
+			want: "YYYY.0M.0D",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv, err := ConvertDetailed(tt.result)
+			if err != nil {
+				t.Fatalf("ConvertDetailed() error = %v", err)
+			}
+			if conv.Config.Versioning.Strategy != "calver" {
+				t.Errorf("Strategy = %q, want calver", conv.Config.Versioning.Strategy)
+			}
+			if conv.Config.Versioning.CalVerFormat != tt.want {
+				t.Errorf("CalVerFormat = %q, want %q", conv.Config.Versioning.CalVerFormat, tt.want)
+			}
+		})
+	}
+}
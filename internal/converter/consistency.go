@@ -0,0 +1,84 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Source is a converted config together with the file it came from.
+// Provenance is the conversion's provenance; when set, only the fields
+// mapped from the source config are compared.
+type Source struct {
+	File       string
+	Config     *RelictaConfig
+	Provenance map[string]string
+}
+
+// CheckConsistency compares configs converted from several files in the
+// same repository and returns a warning for every setting they disagree
+// on: the tag prefix and the allowed branches. A config is only compared
+// on a field its source config set, so tool defaults and assumptions
+// (such as GoReleaser's assumed "v" prefix) never disagree. Configs that
+// leave the branches unset are not compared on branches.
+func CheckConsistency(sources []Source) []Warning {
+	var warnings []Warning
+
+	var withPrefix []Source
+	var prefixes []string
+	for _, src := range sources {
+		if src.sets("versioning.tag_prefix") {
+			withPrefix = append(withPrefix, src)
+			prefixes = append(prefixes, src.Config.Versioning.TagPrefix)
+		}
+	}
+	if w, ok := disagreement("versioning.tag_prefix", "tag prefix", withPrefix, prefixes); ok {
+		warnings = append(warnings, w)
+	}
+
+	var withBranches []Source
+	var branches []string
+	for _, src := range sources {
+		if len(src.Config.Git.AllowedBranches) > 0 && src.sets("git.allowed_branches") {
+			withBranches = append(withBranches, src)
+			branches = append(branches, strings.Join(src.Config.Git.AllowedBranches, ", "))
+		}
+	}
+	if w, ok := disagreement("git.allowed_branches", "allowed branches", withBranches, branches); ok {
+		warnings = append(warnings, w)
+	}
+
+	return warnings
+}
+
+// sets reports whether field of the config was mapped from the source
+// config. Without provenance every field counts as set.
+func (s Source) sets(field string) bool {
+	return s.Provenance == nil || isMapped(s.Provenance[field])
+}
+
+// disagreement builds a warning listing each file's value when values,
+// one per source, are not all equal.
+func disagreement(field, what string, sources []Source, values []string) (Warning, bool) {
+	if len(values) < 2 {
+		return Warning{}, false
+	}
+	same := true
+	for _, v := range values {
+		if v != values[0] {
+			same = false
+			break
+		}
+	}
+	if same {
+		return Warning{}, false
+	}
+
+	parts := make([]string, len(sources))
+	for i, src := range sources {
+		parts[i] = fmt.Sprintf("%s: %q", src.File, values[i])
+	}
+	return Warning{
+		Field:   field,
+		Message: fmt.Sprintf("configs disagree on %s (%s)", what, strings.Join(parts, "; ")),
+	}, true
+}
@@ -3,6 +3,12 @@ package converter
 
 import (
 	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/relicta-tech/migrate/internal/detector"
@@ -10,17 +16,54 @@ import (
 
 // RelictaConfig represents a Relicta release.config.yaml structure.
 type RelictaConfig struct {
+	Project    *ProjectConfig   `yaml:"project,omitempty"`
 	Versioning VersioningConfig `yaml:"versioning"`
 	Changelog  ChangelogConfig  `yaml:"changelog,omitempty"`
 	Git        GitConfig        `yaml:"git,omitempty"`
 	Plugins    []PluginConfig   `yaml:"plugins,omitempty"`
-	AI         *AIConfig        `yaml:"ai,omitempty"`
+	// PluginsFile names a separate file holding the plugins, relative to
+	// this config, when they are not listed inline.
+	PluginsFile string       `yaml:"plugins_file,omitempty"`
+	Hooks       []HookConfig `yaml:"hooks,omitempty"`
+	AI          *AIConfig    `yaml:"ai,omitempty"`
+}
+
+// ProjectConfig holds project metadata.
+type ProjectConfig struct {
+	Name string `yaml:"name"`
 }
 
 // VersioningConfig holds versioning settings.
 type VersioningConfig struct {
-	Strategy  string `yaml:"strategy"`
-	TagPrefix string `yaml:"tag_prefix,omitempty"`
+	Strategy         string        `yaml:"strategy"`
+	TagPrefix        string        `yaml:"tag_prefix,omitempty"`
+	PackageScope     string        `yaml:"package_scope,omitempty"`
+	Bump             string        `yaml:"bump,omitempty"`
+	Prerelease       string        `yaml:"prerelease,omitempty"`
+	SnapshotTemplate string        `yaml:"snapshot_template,omitempty"`
+	CalVerFormat     string        `yaml:"calver_format,omitempty"`
+	ReleaseRules     []ReleaseRule `yaml:"release_rules,omitempty"`
+	// VersionSourceFile is the file the current version is read from.
+	VersionSourceFile *VersionFile `yaml:"version_source_file,omitempty"`
+	// BumpFiles are the files the new version is written to.
+	BumpFiles []VersionFile `yaml:"bump_files,omitempty"`
+	Note      string        `yaml:"_note,omitempty"`
+}
+
+// VersionFile is a file that holds the version. Type is the file format
+// (json, yaml, toml, ini or text), inferred from the extension when
+// empty; Path is the key holding the version in a structured file.
+type VersionFile struct {
+	File string `yaml:"file"`
+	Type string `yaml:"type,omitempty"`
+	Path string `yaml:"path,omitempty"`
+}
+
+// ReleaseRule maps a commit type or label to a release type.
+type ReleaseRule struct {
+	Type    string `yaml:"type,omitempty"`
+	Label   string `yaml:"label,omitempty"`
+	Release string `yaml:"release"`
 }
 
 // ChangelogConfig holds changelog settings.
@@ -28,17 +71,59 @@ type ChangelogConfig struct {
 	Enabled  bool   `yaml:"enabled"`
 	Template string `yaml:"template,omitempty"`
 	File     string `yaml:"file,omitempty"`
+	Preset   string `yaml:"preset,omitempty"`
+	// Source is where changes are read from: git (the default), or a
+	// forge's API such as github or gitlab.
+	Source          string             `yaml:"source,omitempty"`
+	Header          string             `yaml:"header,omitempty"`
+	Footer          string             `yaml:"footer,omitempty"`
+	Sections        []ChangelogSection `yaml:"sections,omitempty"`
+	CommitURLFormat string             `yaml:"commit_url_format,omitempty"`
+	IssueURLFormat  string             `yaml:"issue_url_format,omitempty"`
+	// Editor is the command that opens the new changelog entry for
+	// editing before the release; empty skips the edit.
+	Editor string `yaml:"editor,omitempty"`
+}
+
+// ChangelogSection groups changelog entries under a title.
+type ChangelogSection struct {
+	Title  string   `yaml:"title"`
+	Types  []string `yaml:"types,omitempty"`
+	Labels []string `yaml:"labels,omitempty"`
+	Hidden bool     `yaml:"hidden,omitempty"`
 }
 
 // GitConfig holds git settings.
 type GitConfig struct {
-	RequireCleanTree   bool   `yaml:"require_clean_tree"`
-	PushTags           bool   `yaml:"push_tags"`
-	CreateTag          bool   `yaml:"create_tag"`
-	CommitMessage      string `yaml:"commit_message,omitempty"`
-	TagMessage         string `yaml:"tag_message,omitempty"`
-	RequireUpToDate    bool   `yaml:"require_up_to_date,omitempty"`
-	AllowedBranches    []string `yaml:"allowed_branches,omitempty"`
+	RequireCleanTree    bool                `yaml:"require_clean_tree"`
+	PushTags            bool                `yaml:"push_tags"`
+	CreateTag           bool                `yaml:"create_tag"`
+	CommitMessage       string              `yaml:"commit_message,omitempty"`
+	TagMessage          string              `yaml:"tag_message,omitempty"`
+	RequireUpToDate     bool                `yaml:"require_up_to_date,omitempty"`
+	AllowedBranches     []string            `yaml:"allowed_branches,omitempty"`
+	MaintenanceBranches []MaintenanceBranch `yaml:"maintenance_branches,omitempty"`
+	// Branches holds the full branch objects of the source config when
+	// they carry more than names; AllowedBranches lists their names.
+	Branches []BranchConfig `yaml:"branches,omitempty"`
+}
+
+// BranchConfig is a release branch with its settings. Keys the converter
+// does not know, such as ones read by a shareable config, are kept in
+// Extra.
+type BranchConfig struct {
+	Name       string         `yaml:"name"`
+	Channel    string         `yaml:"channel,omitempty"`
+	Range      string         `yaml:"range,omitempty"`
+	Prerelease string         `yaml:"prerelease,omitempty"`
+	Extra      map[string]any `yaml:",inline"`
+}
+
+// MaintenanceBranch describes a maintenance release line such as 1.x.
+type MaintenanceBranch struct {
+	Name    string `yaml:"name"`
+	Range   string `yaml:"range"`
+	Channel string `yaml:"channel,omitempty"`
 }
 
 // PluginConfig holds plugin settings.
@@ -48,30 +133,262 @@ type PluginConfig struct {
 	Config  map[string]any `yaml:"config,omitempty"`
 }
 
+// HookConfig runs a shell command at a release phase. Hooks run in the
+// order they are listed.
+type HookConfig struct {
+	Phase   string `yaml:"phase"`
+	Command string `yaml:"command"`
+}
+
 // AIConfig holds AI settings.
 type AIConfig struct {
 	Enabled  bool   `yaml:"enabled"`
 	Provider string `yaml:"provider,omitempty"`
 }
 
+// Warning describes a source setting that could not be converted
+// automatically and needs manual attention.
+type Warning struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// String formats the warning for display.
+func (w Warning) String() string {
+	if w.Field == "" {
+		return w.Message
+	}
+	return w.Field + ": " + w.Message
+}
+
+// Conversion holds a converted config together with the warnings
+// collected while converting it.
+type Conversion struct {
+	Config   *RelictaConfig
+	Warnings []Warning
+	// Provenance notes where each Relicta field's value came from, keyed
+	// by field path (e.g. "versioning.tag_prefix"). Plugins are keyed as
+	// "plugins.<name>".
+	Provenance map[string]string
+	// consumed holds the top-level source keys that were read without
+	// becoming a Relicta field, such as GoReleaser's schema version.
+	consumed map[string]bool
+}
+
+// newConversion wraps a freshly defaulted config and records provenance
+// for the defaults every converter starts from.
+func newConversion(config *RelictaConfig) *Conversion {
+	conv := &Conversion{
+		Config:     config,
+		Provenance: make(map[string]string),
+	}
+	conv.assume("versioning.strategy", "default strategy, source tool did not specify")
+	conv.assume("changelog.enabled", "default, changelog generation enabled")
+	conv.assume("changelog.file", "default changelog file")
+	conv.assume("git.require_clean_tree", "Relicta default")
+	conv.assume("git.push_tags", "Relicta default")
+	conv.assume("git.create_tag", "Relicta default")
+	return conv
+}
+
+// assume records that a field holds an inferred default value.
+func (c *Conversion) assume(field, note string) {
+	c.Provenance[field] = note
+}
+
+// mapped records that a field was translated from a source config key.
+func (c *Conversion) mapped(field, source string) {
+	c.Provenance[field] = "from " + source
+}
+
+// consume records that the top-level source key was read, although no
+// Relicta field holds it, so it is not reported as unmapped.
+func (c *Conversion) consume(key string) {
+	if c.consumed == nil {
+		c.consumed = make(map[string]bool)
+	}
+	c.consumed[key] = true
+}
+
+// Mapping records that a Relicta field was translated from a source key.
+type Mapping struct {
+	Source string
+	Field  string
+}
+
+// Mappings lists every field translated from the source config, sorted by
+// Relicta field.
+func (c *Conversion) Mappings() []Mapping {
+	var mappings []Mapping
+	for field, note := range c.Provenance {
+		if source, ok := strings.CutPrefix(note, "from "); ok {
+			mappings = append(mappings, Mapping{Source: source, Field: field})
+		}
+	}
+	sort.Slice(mappings, func(i, j int) bool {
+		return mappings[i].Field < mappings[j].Field
+	})
+	return mappings
+}
+
+// SourceKey returns the top-level source config key a mapping was read
+// from, e.g. "git" for "git.tagName" or "plugins" for
+// "plugins[@semantic-release/npm]".
+func (m Mapping) SourceKey() string {
+	if i := strings.IndexAny(m.Source, ".["); i >= 0 {
+		return m.Source[:i]
+	}
+	return m.Source
+}
+
+// SourceCoverage reports how many of the top-level keys of source, the
+// config that was converted, at least one Relicta field was mapped from.
+// Keys starting with "_" are not counted.
+func (c *Conversion) SourceCoverage(source map[string]any) (mapped, total int) {
+	unmapped := c.UnmappedKeys(source)
+	for key := range source {
+		if !strings.HasPrefix(key, "_") {
+			total++
+		}
+	}
+	return total - len(unmapped), total
+}
+
+// UnmappedKeys lists, sorted, the top-level keys of source that no
+// Relicta field was mapped from and that were not otherwise read. Keys
+// starting with "_" are skipped.
+func (c *Conversion) UnmappedKeys(source map[string]any) []string {
+	keys := make(map[string]bool)
+	for key := range c.consumed {
+		keys[key] = true
+	}
+	for _, m := range c.Mappings() {
+		keys[m.SourceKey()] = true
+	}
+	var unmapped []string
+	for key := range source {
+		if !keys[key] && !strings.HasPrefix(key, "_") {
+			unmapped = append(unmapped, key)
+		}
+	}
+	sort.Strings(unmapped)
+	return unmapped
+}
+
+// Coverage reports how many of the fields with recorded provenance were
+// translated from the source config rather than filled in with defaults.
+func (c *Conversion) Coverage() (mapped, total int) {
+	for _, note := range c.Provenance {
+		if strings.HasPrefix(note, "from ") {
+			mapped++
+		}
+	}
+	return mapped, len(c.Provenance)
+}
+
+// Assumption is a field the converter filled in with a default because
+// the source config did not set it.
+type Assumption struct {
+	Field string
+	Value any // nil for sections such as plugins
+	Note  string
+}
+
+// Assumptions lists the fields holding inferred defaults, sorted by field,
+// so they can be double-checked. Fields overridden with Set are not
+// included.
+func (c *Conversion) Assumptions() []Assumption {
+	var assumptions []Assumption
+	for field, note := range c.Provenance {
+		if strings.HasPrefix(note, "from ") || note == setNote {
+			continue
+		}
+		assumptions = append(assumptions, Assumption{
+			Field: field,
+			Value: fieldValue(reflect.ValueOf(c.Config).Elem(), strings.Split(field, ".")),
+			Note:  note,
+		})
+	}
+	sort.Slice(assumptions, func(i, j int) bool {
+		return assumptions[i].Field < assumptions[j].Field
+	})
+	return assumptions
+}
+
+// warn records a warning for the given source field.
+func (c *Conversion) warn(field, format string, args ...any) {
+	c.Warnings = append(c.Warnings, Warning{
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// NewDefaultConfig returns a starter config for a project that has no
+// release tool yet: conventional versioning, a changelog and GitHub
+// releases from a single branch.
+func NewDefaultConfig(tagPrefix, branch string) *RelictaConfig {
+	return &RelictaConfig{
+		Versioning: VersioningConfig{
+			Strategy:  "conventional",
+			TagPrefix: tagPrefix,
+		},
+		Changelog: ChangelogConfig{
+			Enabled: true,
+			File:    "CHANGELOG.md",
+		},
+		Git: GitConfig{
+			RequireCleanTree: true,
+			PushTags:         true,
+			CreateTag:        true,
+			AllowedBranches:  []string{branch},
+		},
+		Plugins: []PluginConfig{
+			{Name: "github", Enabled: true},
+		},
+	}
+}
+
 // Convert transforms a detected config to Relicta format.
 func Convert(result *detector.Result) (*RelictaConfig, error) {
-	switch result.Tool {
-	case detector.ToolSemanticRelease:
-		return convertSemanticRelease(result)
-	case detector.ToolReleaseIt:
-		return convertReleaseIt(result)
-	case detector.ToolStandardVersion:
-		return convertStandardVersion(result)
-	case detector.ToolGoReleaser:
-		return convertGoReleaser(result)
-	default:
-		return nil, fmt.Errorf("unsupported tool: %s", result.Tool)
+	conv, err := ConvertDetailed(result)
+	if err != nil {
+		return nil, err
 	}
+	return conv.Config, nil
+}
+
+// ConvertDetailed transforms a detected config to Relicta format and
+// reports any settings that need manual attention.
+func ConvertDetailed(result *detector.Result) (*Conversion, error) {
+	c, ok := converters[result.Tool]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedTool, result.Tool)
+	}
+	conv, err := c.Convert(result)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, w := range result.Warnings {
+		conv.warn("", "%s", w)
+	}
+
+	if isJS, _ := result.ConfigData["_jsConfig"].(bool); isJS {
+		if reason, ok := result.ConfigData["_jsError"].(string); ok {
+			conv.warn("", "JavaScript config %s cannot be parsed (%s); review the generated config manually", result.ConfigFile, reason)
+		} else {
+			conv.warn("", "JavaScript config %s cannot be parsed; review the generated config manually", result.ConfigFile)
+		}
+	}
+
+	checkEnv(conv, result.Tool)
+	validate(conv)
+
+	return conv, nil
 }
 
 // convertSemanticRelease converts semantic-release config to Relicta.
-func convertSemanticRelease(result *detector.Result) (*RelictaConfig, error) {
+func convertSemanticRelease(result *detector.Result) (*Conversion, error) {
 	data := result.ConfigData
 	config := &RelictaConfig{
 		Versioning: VersioningConfig{
@@ -87,32 +404,140 @@ func convertSemanticRelease(result *detector.Result) (*RelictaConfig, error) {
 			CreateTag:        true,
 		},
 	}
+	conv := newConversion(config)
+	projectFromPackageJSON(result, conv)
 
 	// Extract tag format
 	if tagFormat, ok := data["tagFormat"].(string); ok {
 		// semantic-release uses "${version}" syntax
 		// Extract prefix (e.g., "v${version}" -> "v")
 		prefix := strings.TrimSuffix(tagFormat, "${version}")
-		if prefix != "" {
-			config.Versioning.TagPrefix = prefix
+		config.Versioning.TagPrefix = prefix
+		conv.mapped("versioning.tag_prefix", "tagFormat")
+		// Monorepo tags name the package, e.g. "my-pkg-v${version}"
+		if scope := packageScope(prefix, result); scope != "" {
+			config.Versioning.PackageScope = scope
+			conv.mapped("versioning.package_scope", "tagFormat")
 		}
 	}
 
 	// Extract branches
-	if branches, ok := data["branches"].([]any); ok {
+	if list, ok := data["branches"].([]any); ok {
+		branches := parseBranches(list)
 		config.Git.AllowedBranches = extractBranches(branches)
+		config.Git.MaintenanceBranches = extractMaintenanceBranches(branches)
+		conv.mapped("git.allowed_branches", "branches")
+		if len(config.Git.MaintenanceBranches) > 0 {
+			conv.mapped("git.maintenance_branches", "branches")
+		}
+		for _, b := range list {
+			if _, ok := b.(map[string]any); ok {
+				config.Git.Branches = branches
+				conv.mapped("git.branches", "branches")
+				break
+			}
+		}
 	}
 
 	// Convert plugins
 	if plugins, ok := data["plugins"].([]any); ok {
-		config.Plugins = convertSemanticReleasePlugins(plugins)
+		config.Plugins = convertSemanticReleasePlugins(plugins, conv)
+	}
+	if assets, ok := data["assets"]; ok {
+		convertSemanticReleaseAssets(assets, conv)
 	}
 
-	return config, nil
+	// Top-level preset options apply to every plugin that reads them,
+	// unless the plugin sets its own
+	convertSemanticReleasePreset("", false, data, conv)
+
+	// Shareable configs are not resolved, so their settings are missing
+	var extends []string
+	switch e := data["extends"].(type) {
+	case string:
+		extends = strings.Fields(e)
+	case []any:
+		extends = toStringSlice(e)
+	}
+	if len(extends) > 0 {
+		if len(data) == 1 {
+			conv.warn("extends", "config only extends %s; the real config is external and this conversion holds defaults only", strings.Join(extends, ", "))
+		} else {
+			conv.warn("extends", "extended config %s is not resolved; settings it provides are missing from this conversion", strings.Join(extends, ", "))
+		}
+	}
+
+	return conv, nil
+}
+
+// convertSemanticReleaseAssets attaches the top-level assets option of
+// older semantic-release configs, which applied to every plugin, to the
+// github plugin, adding the plugin when the config does not list it.
+// Assets configured on the plugin itself take precedence.
+func convertSemanticReleaseAssets(assets any, conv *Conversion) {
+	for i := range conv.Config.Plugins {
+		plugin := &conv.Config.Plugins[i]
+		if plugin.Name != "github" {
+			continue
+		}
+		if _, ok := plugin.Config["assets"]; ok {
+			conv.warn("assets", "top-level assets are ignored because the github plugin sets its own")
+			return
+		}
+		config := make(map[string]any, len(plugin.Config)+1)
+		for k, v := range plugin.Config {
+			config[k] = v
+		}
+		config["assets"] = assets
+		plugin.Config = config
+		conv.mapped("plugins.github.config.assets", "assets")
+		return
+	}
+
+	conv.Config.Plugins = append(conv.Config.Plugins, PluginConfig{
+		Name:    "github",
+		Enabled: true,
+		Config:  map[string]any{"assets": assets},
+	})
+	conv.mapped("plugins.github", "assets")
+}
+
+// projectFromPackageJSON names the project after the package.json next
+// to a JavaScript tool's config, as recorded by the detector.
+func projectFromPackageJSON(result *detector.Result, conv *Conversion) {
+	if name, ok := result.Details["packageName"].(string); ok && name != "" {
+		conv.Config.Project = &ProjectConfig{Name: name}
+		conv.assume("project.name", "name in package.json")
+	}
+}
+
+// packageScope returns the package a monorepo tag prefix is qualified
+// with: "@scope/pkg" for "@scope/pkg@" or "pkg" for "pkg@", and "my-pkg"
+// for "my-pkg-v" or "tools/cli" for "tools/cli/v" when the config belongs
+// to a workspace package or the scope is its package.json name. It
+// returns "" for plain prefixes such as "v" or "release-".
+func packageScope(prefix string, result *detector.Result) string {
+	if scope, ok := strings.CutSuffix(prefix, "@"); ok {
+		return scope
+	}
+	rest, ok := strings.CutSuffix(prefix, "v")
+	if !ok {
+		return ""
+	}
+	scope := strings.TrimRight(rest, "-_/")
+	if scope == rest || scope == "" {
+		return ""
+	}
+	workspace, _ := result.Details["workspacePackage"].(bool)
+	name, _ := result.Details["packageName"].(string)
+	if !workspace && scope != name {
+		return ""
+	}
+	return scope
 }
 
 // convertReleaseIt converts release-it config to Relicta.
-func convertReleaseIt(result *detector.Result) (*RelictaConfig, error) {
+func convertReleaseIt(result *detector.Result) (*Conversion, error) {
 	data := result.ConfigData
 	config := &RelictaConfig{
 		Versioning: VersioningConfig{
@@ -128,6 +553,8 @@ func convertReleaseIt(result *detector.Result) (*RelictaConfig, error) {
 			CreateTag:        true,
 		},
 	}
+	conv := newConversion(config)
+	projectFromPackageJSON(result, conv)
 
 	// Extract git config
 	if git, ok := data["git"].(map[string]any); ok {
@@ -136,29 +563,86 @@ func convertReleaseIt(result *detector.Result) (*RelictaConfig, error) {
 			prefix := strings.TrimSuffix(tagName, "${version}")
 			if prefix != "" {
 				config.Versioning.TagPrefix = prefix
+				conv.mapped("versioning.tag_prefix", "git.tagName")
 			}
 		}
 		if commitMessage, ok := git["commitMessage"].(string); ok {
-			config.Git.CommitMessage = convertTemplate(commitMessage)
+			config.Git.CommitMessage = convertMessage(commitMessage)
+			conv.mapped("git.commit_message", "git.commitMessage")
 		}
 		if tagAnnotation, ok := git["tagAnnotation"].(string); ok {
-			config.Git.TagMessage = convertTemplate(tagAnnotation)
+			config.Git.TagMessage = convertMessage(tagAnnotation)
+			conv.mapped("git.tag_message", "git.tagAnnotation")
 		}
 		if requireCleanWorkingDir, ok := git["requireCleanWorkingDir"].(bool); ok {
 			config.Git.RequireCleanTree = requireCleanWorkingDir
+			conv.mapped("git.require_clean_tree", "git.requireCleanWorkingDir")
 		}
 		if push, ok := git["push"].(bool); ok {
 			config.Git.PushTags = push
+			conv.mapped("git.push_tags", "git.push")
+		}
+		switch changelog := git["changelog"].(type) {
+		case string:
+			// The command generated the release notes; Relicta's own
+			// changelog takes its place
+			config.Changelog.Enabled = true
+			conv.mapped("changelog.enabled", "git.changelog")
+			conv.warn("changelog", "git.changelog command %q is not run by Relicta; the built-in changelog is generated instead", changelog)
+		case bool:
+			config.Changelog.Enabled = changelog
+			conv.mapped("changelog.enabled", "git.changelog")
+		}
+		if tagExclude, ok := git["tagExclude"].(string); ok && tagExclude != "" {
+			config.Versioning.Note = fmt.Sprintf("release-it ignored tags matching %q (git.tagExclude) when finding the latest version; Relicta has no equivalent, so make sure no such tags match the tag prefix.", tagExclude)
+			conv.mapped("versioning._note", "git.tagExclude")
 		}
 	}
 
+	// Extract increment and prerelease identifier
+	switch increment := data["increment"].(type) {
+	case string:
+		convertReleaseItIncrement(increment, conv)
+	case bool:
+		if !increment {
+			// Publish-only: the version in package.json is released as is
+			config.Versioning.Strategy = "manual"
+			config.Versioning.Bump = "none"
+			conv.mapped("versioning.strategy", "increment")
+			conv.mapped("versioning.bump", "increment")
+		}
+	}
+	if preReleaseID, ok := data["preReleaseId"].(string); ok && preReleaseID != "" {
+		config.Versioning.Prerelease = preReleaseID
+		conv.mapped("versioning.prerelease", "preReleaseId")
+	}
+
 	// Extract npm config
 	if npm, ok := data["npm"].(map[string]any); ok {
 		if publish, ok := npm["publish"].(bool); ok && publish {
-			config.Plugins = append(config.Plugins, PluginConfig{
+			npmConfig := PluginConfig{
 				Name:    "npm",
 				Enabled: true,
-			})
+			}
+			// publishPath publishes a built directory such as dist
+			// instead of the repository root
+			if publishPath, ok := npm["publishPath"].(string); ok && publishPath != "" && publishPath != "." {
+				npmConfig.Config = map[string]any{"package_root": publishPath}
+			}
+			if args, ok := npm["versionArgs"].([]any); ok && len(args) > 0 {
+				if npmConfig.Config == nil {
+					npmConfig.Config = make(map[string]any)
+				}
+				npmConfig.Config["_note"] = fmt.Sprintf("release-it ran npm version with %s; Relicta sets the version itself, check whether these arguments are still needed", strings.Join(toStringSlice(args), " "))
+			}
+			config.Plugins = append(config.Plugins, npmConfig)
+			conv.mapped("plugins.npm", "npm.publish")
+			if npmConfig.Config["package_root"] != nil {
+				conv.mapped("plugins.npm.config.package_root", "npm.publishPath")
+			}
+			if npmConfig.Config["_note"] != nil {
+				conv.mapped("plugins.npm.config._note", "npm.versionArgs")
+			}
 		}
 	}
 
@@ -176,7 +660,11 @@ func convertReleaseIt(result *detector.Result) (*RelictaConfig, error) {
 			if preRelease, ok := github["preRelease"].(bool); ok {
 				ghConfig.Config["prerelease"] = preRelease
 			}
+			if assets := releaseItAssets(github["assets"]); len(assets) > 0 {
+				ghConfig.Config["assets"] = assets
+			}
 			config.Plugins = append(config.Plugins, ghConfig)
+			conv.mapped("plugins.github", "github")
 		}
 	}
 
@@ -187,14 +675,302 @@ func convertReleaseIt(result *detector.Result) (*RelictaConfig, error) {
 				Name:    "gitlab",
 				Enabled: true,
 			})
+			conv.mapped("plugins.gitlab", "gitlab")
+		}
+	}
+
+	// Extract plugins
+	if plugins, ok := data["plugins"].(map[string]any); ok {
+		convertReleaseItPlugins(plugins, result.Details, conv)
+	}
+
+	// Extract hooks
+	if hooks, ok := data["hooks"].(map[string]any); ok {
+		convertReleaseItHooks(hooks, conv)
+	}
+
+	return conv, nil
+}
+
+// releaseItHookPhases lists release-it's lifecycle hooks in the order
+// release-it runs them, with the Relicta hook phase each one maps to.
+var releaseItHookPhases = []struct {
+	hook  string
+	phase string
+}{
+	{"before:init", "verify_conditions"},
+	{"after:init", "verify_conditions"},
+	{"before:bump", "prepare"},
+	{"after:bump", "prepare"},
+	{"before:release", "prepare"},
+	{"after:release", "success"},
+}
+
+// convertReleaseItHooks converts release-it hooks, each a command or a
+// list of commands, into hooks in lifecycle order. Commands keep their
+// order within a hook. Plugin-scoped hooks such as "after:git:release"
+// run at the phase of their lifecycle step and are reported.
+func convertReleaseItHooks(hooks map[string]any, conv *Conversion) {
+	names := make([]string, 0, len(hooks))
+	for name := range hooks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var converted []HookConfig
+	used := make(map[string]bool, len(hooks))
+	for _, p := range releaseItHookPhases {
+		when, step, _ := strings.Cut(p.hook, ":")
+		for _, name := range names {
+			parts := strings.Split(name, ":")
+			if len(parts) < 2 || len(parts) > 3 || parts[0] != when || parts[len(parts)-1] != step {
+				continue
+			}
+			used[name] = true
+			if len(parts) == 3 {
+				conv.warn("hooks", "release-it hook %q runs at the %s phase rather than around the %s plugin", name, p.phase, parts[1])
+			}
+			for _, cmd := range releaseItHookCommands(hooks[name]) {
+				converted = append(converted, HookConfig{Phase: p.phase, Command: convertTemplate(cmd)})
+			}
+		}
+	}
+	for _, name := range names {
+		if !used[name] {
+			conv.warn("hooks", "release-it hook %q has no Relicta equivalent", name)
+		}
+	}
+
+	if len(converted) > 0 {
+		conv.Config.Hooks = append(conv.Config.Hooks, converted...)
+		conv.mapped("hooks", "hooks")
+	}
+}
+
+// releaseItHookCommands returns the commands of a release-it hook, which
+// may be a single command or a list of them.
+func releaseItHookCommands(value any) []string {
+	switch v := value.(type) {
+	case string:
+		if v != "" {
+			return []string{v}
+		}
+	case []any:
+		var cmds []string
+		for _, item := range v {
+			if cmd, ok := item.(string); ok && cmd != "" {
+				cmds = append(cmds, cmd)
+			}
+		}
+		return cmds
+	}
+	return nil
+}
+
+// convertReleaseItPlugins converts release-it plugins, which are configured
+// as a map of plugin name to options. Plugins without a Relicta equivalent
+// are reported.
+func convertReleaseItPlugins(plugins, details map[string]any, conv *Conversion) {
+	names := make([]string, 0, len(plugins))
+	for name := range plugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		options, _ := plugins[name].(map[string]any)
+		switch name {
+		case "@release-it/bumper":
+			convertReleaseItBumper(options, conv)
+		case "@release-it-plugins/lerna-changelog", "release-it-lerna-changelog":
+			convertLernaChangelog(name, options, details, conv)
+		default:
+			conv.warn("plugins", "release-it plugin %s requires manual migration", name)
+		}
+	}
+}
+
+// lernaChangelogDefaultLabels are the labels lerna-changelog groups pull
+// requests by when none are configured, in its section order.
+var lernaChangelogDefaultLabels = []ChangelogSection{
+	{Title: ":boom: Breaking Change", Labels: []string{"breaking"}},
+	{Title: ":rocket: Enhancement", Labels: []string{"enhancement"}},
+	{Title: ":bug: Bug Fix", Labels: []string{"bug"}},
+	{Title: ":memo: Documentation", Labels: []string{"documentation"}},
+	{Title: ":house: Internal", Labels: []string{"internal"}},
+}
+
+// convertLernaChangelog maps the lerna-changelog plugin, which writes a
+// changelog grouped by pull request labels: infile becomes the changelog
+// file, launchEditor the editor, and the label to section mapping read
+// by the detector (or lerna-changelog's defaults) the sections.
+func convertLernaChangelog(name string, options, details map[string]any, conv *Conversion) {
+	config := conv.Config
+	source := "plugins[" + name + "]"
+
+	config.Changelog.Enabled = true
+	conv.mapped("changelog.enabled", source)
+
+	switch infile := options["infile"].(type) {
+	case string:
+		if infile != "" {
+			config.Changelog.File = infile
+			conv.mapped("changelog.file", source+".infile")
+		}
+	case bool:
+		// infile: false only feeds the release notes
+		if !infile {
+			config.Changelog.File = ""
+			conv.mapped("changelog.file", source+".infile")
+		}
+	}
+
+	switch editor := options["launchEditor"].(type) {
+	case string:
+		if editor != "" {
+			config.Changelog.Editor = convertTemplate(editor)
+			conv.mapped("changelog.editor", source+".launchEditor")
+		}
+	case bool:
+		if editor {
+			config.Changelog.Editor = "{{.Env.EDITOR}}"
+			conv.mapped("changelog.editor", source+".launchEditor")
+		}
+	}
+
+	labels, _ := details["changelogLabels"].([]any)
+	sections := lernaChangelogSections(labels)
+	if len(sections) == 0 {
+		sections = append([]ChangelogSection(nil), lernaChangelogDefaultLabels...)
+		config.Changelog.Sections = sections
+		conv.assume("changelog.sections", "lerna-changelog default labels")
+		return
+	}
+	config.Changelog.Sections = sections
+	conv.mapped("changelog.sections", source+" labels")
+}
+
+// lernaChangelogSections groups label/section pairs into sections in the
+// order they are first listed; labels sharing a title share a section.
+func lernaChangelogSections(labels []any) []ChangelogSection {
+	var sections []ChangelogSection
+	index := make(map[string]int)
+	for _, l := range labels {
+		pair, _ := l.(map[string]any)
+		label, _ := pair["label"].(string)
+		title, _ := pair["section"].(string)
+		if label == "" || title == "" {
+			continue
+		}
+		i, ok := index[title]
+		if !ok {
+			i = len(sections)
+			index[title] = i
+			sections = append(sections, ChangelogSection{Title: title})
+		}
+		sections[i].Labels = append(sections[i].Labels, label)
+	}
+	return sections
+}
+
+// convertReleaseItBumper maps @release-it/bumper's in file, where the
+// version is read from, and its out files, where it is written to.
+func convertReleaseItBumper(options map[string]any, conv *Conversion) {
+	source := "plugins[@release-it/bumper]"
+	if in, ok := bumperFile(options["in"]); ok {
+		conv.Config.Versioning.VersionSourceFile = &in
+		conv.mapped("versioning.version_source_file", source+".in")
+	}
+
+	var out []any
+	switch o := options["out"].(type) {
+	case []any:
+		out = o
+	case nil:
+	default:
+		out = []any{o}
+	}
+	for _, entry := range out {
+		if file, ok := bumperFile(entry); ok {
+			conv.Config.Versioning.BumpFiles = append(conv.Config.Versioning.BumpFiles, file)
+		}
+	}
+	if len(conv.Config.Versioning.BumpFiles) > 0 {
+		conv.mapped("versioning.bump_files", source+".out")
+	}
+}
+
+// bumperFileTypes maps @release-it/bumper MIME types to file types.
+var bumperFileTypes = map[string]string{
+	"application/json":   "json",
+	"text/yaml":          "yaml",
+	"application/x-yaml": "yaml",
+	"text/x-yaml":        "yaml",
+	"application/toml":   "toml",
+	"text/toml":          "toml",
+	"text/x-ini":         "ini",
+	"text/plain":         "text",
+}
+
+// bumperFile reads a bumper file entry: a file name, or an object with
+// file and optional type and path.
+func bumperFile(entry any) (VersionFile, bool) {
+	switch e := entry.(type) {
+	case string:
+		return VersionFile{File: e}, e != ""
+	case map[string]any:
+		file, _ := e["file"].(string)
+		if file == "" {
+			return VersionFile{}, false
+		}
+		typ, _ := e["type"].(string)
+		if short, ok := bumperFileTypes[typ]; ok {
+			typ = short
+		}
+		path, _ := e["path"].(string)
+		return VersionFile{File: file, Type: typ, Path: path}, true
+	}
+	return VersionFile{}, false
+}
+
+// releaseItAssets normalizes release-it's assets option, which is either a
+// single glob or a list of globs.
+func releaseItAssets(value any) []string {
+	switch v := value.(type) {
+	case string:
+		if v != "" {
+			return []string{v}
+		}
+	case []any:
+		return toStringSlice(v)
+	}
+	return nil
+}
+
+// convertReleaseItIncrement maps release-it's increment setting.
+// "conventional:<preset>" keeps the conventional strategy; a fixed
+// increment such as "minor" becomes a manual strategy with a forced bump.
+func convertReleaseItIncrement(increment string, conv *Conversion) {
+	config := conv.Config
+
+	if preset, ok := strings.CutPrefix(increment, "conventional"); ok {
+		config.Versioning.Strategy = "conventional"
+		conv.mapped("versioning.strategy", "increment")
+		if preset = strings.TrimPrefix(preset, ":"); preset != "" {
+			config.Changelog.Preset = mapChangelogPreset(preset, conv)
+			conv.mapped("changelog.preset", "increment")
 		}
+		return
 	}
 
-	return config, nil
+	config.Versioning.Strategy = "manual"
+	config.Versioning.Bump = increment
+	conv.mapped("versioning.strategy", "increment")
+	conv.mapped("versioning.bump", "increment")
 }
 
 // convertStandardVersion converts standard-version config to Relicta.
-func convertStandardVersion(result *detector.Result) (*RelictaConfig, error) {
+func convertStandardVersion(result *detector.Result) (*Conversion, error) {
 	data := result.ConfigData
 	config := &RelictaConfig{
 		Versioning: VersioningConfig{
@@ -210,53 +986,206 @@ func convertStandardVersion(result *detector.Result) (*RelictaConfig, error) {
 			CreateTag:        true,
 		},
 	}
+	conv := newConversion(config)
+	projectFromPackageJSON(result, conv)
 
 	// Extract tag prefix
 	if tagPrefix, ok := data["tagPrefix"].(string); ok {
 		config.Versioning.TagPrefix = tagPrefix
+		conv.mapped("versioning.tag_prefix", "tagPrefix")
 	}
 
 	// Extract skip options
 	if skip, ok := data["skip"].(map[string]any); ok {
 		if skipChangelog, ok := skip["changelog"].(bool); ok && skipChangelog {
 			config.Changelog.Enabled = false
+			conv.mapped("changelog.enabled", "skip.changelog")
 		}
 		if skipTag, ok := skip["tag"].(bool); ok && skipTag {
 			config.Git.CreateTag = false
+			conv.mapped("git.create_tag", "skip.tag")
 		}
 	}
 
 	// Extract commit message
 	if releaseCommitMessageFormat, ok := data["releaseCommitMessageFormat"].(string); ok {
-		config.Git.CommitMessage = convertTemplate(releaseCommitMessageFormat)
+		config.Git.CommitMessage = convertMessage(releaseCommitMessageFormat)
+		conv.mapped("git.commit_message", "releaseCommitMessageFormat")
 	}
 
 	// Extract changelog file path
 	if infile, ok := data["infile"].(string); ok {
 		config.Changelog.File = infile
+		conv.mapped("changelog.file", "infile")
+	}
+
+	// Extract conventional-changelog settings
+	if preset, ok := data["preset"].(string); ok {
+		config.Changelog.Preset = mapChangelogPreset(preset, conv)
+		conv.mapped("changelog.preset", "preset")
+	}
+	if header, ok := data["header"].(string); ok {
+		config.Changelog.Header = header
+		conv.mapped("changelog.header", "header")
+	}
+	if types, ok := data["types"].([]any); ok {
+		config.Changelog.Sections = convertChangelogTypes(types)
+		conv.mapped("changelog.sections", "types")
+	}
+	if commitURLFormat, ok := data["commitUrlFormat"].(string); ok {
+		config.Changelog.CommitURLFormat = commitURLFormat
+		conv.mapped("changelog.commit_url_format", "commitUrlFormat")
+	}
+	if issueURLFormat, ok := data["issueUrlFormat"].(string); ok {
+		config.Changelog.IssueURLFormat = issueURLFormat
+		conv.mapped("changelog.issue_url_format", "issueUrlFormat")
 	}
 
-	return config, nil
+	// A prebump script prints the version to release; a date there means
+	// the project uses CalVer
+	if scripts, ok := data["scripts"].(map[string]any); ok {
+		if prebump, ok := scripts["prebump"].(string); ok {
+			if format, ok := calVerFromScript(prebump); ok {
+				config.Versioning.Strategy = "calver"
+				config.Versioning.CalVerFormat = format
+				conv.mapped("versioning.strategy", "scripts.prebump")
+				conv.mapped("versioning.calver_format", "scripts.prebump")
+			}
+		}
+	}
+
+	return conv, nil
 }
 
-// extractBranches extracts branch names from semantic-release branches config.
-func extractBranches(branches []any) []string {
-	var result []string
+// mapChangelogPreset maps a conventional-changelog preset name to the
+// Relicta changelog preset.
+func mapChangelogPreset(preset string, conv *Conversion) string {
+	name := strings.TrimPrefix(preset, "conventional-changelog-")
+	switch name {
+	case "conventionalcommits":
+		return "conventional"
+	case "angular":
+		return "angular"
+	default:
+		conv.warn("preset", "preset %q has no Relicta equivalent; kept as-is", preset)
+		return name
+	}
+}
+
+// convertChangelogTypes converts conventional-changelog types entries
+// ({type, section, hidden}) to changelog sections. Types sharing a section
+// title are grouped together.
+func convertChangelogTypes(types []any) []ChangelogSection {
+	var sections []ChangelogSection
+	index := make(map[string]int)
+
+	for _, t := range types {
+		entry, ok := t.(map[string]any)
+		if !ok {
+			continue
+		}
+		typ, _ := entry["type"].(string)
+		if typ == "" {
+			continue
+		}
+		title, _ := entry["section"].(string)
+		if title == "" {
+			title = typ
+		}
+		hidden, _ := entry["hidden"].(bool)
+
+		key := fmt.Sprintf("%s/%t", title, hidden)
+		if i, ok := index[key]; ok {
+			sections[i].Types = append(sections[i].Types, typ)
+			continue
+		}
+		index[key] = len(sections)
+		sections = append(sections, ChangelogSection{
+			Title:  title,
+			Types:  []string{typ},
+			Hidden: hidden,
+		})
+	}
+
+	return sections
+}
+
+// parseBranches reads semantic-release branches config, where a branch is
+// a name or an object. A prerelease of true means the branch name is the
+// prerelease identifier, and a channel of false is the default channel.
+// Objects without a name are skipped.
+func parseBranches(branches []any) []BranchConfig {
+	var result []BranchConfig
 	for _, b := range branches {
 		switch branch := b.(type) {
 		case string:
-			result = append(result, branch)
+			result = append(result, BranchConfig{Name: branch})
 		case map[string]any:
-			if name, ok := branch["name"].(string); ok {
-				result = append(result, name)
+			bc := BranchConfig{}
+			bc.Name, _ = branch["name"].(string)
+			if bc.Name == "" {
+				continue
+			}
+			bc.Channel, _ = branch["channel"].(string)
+			bc.Range, _ = branch["range"].(string)
+			switch prerelease := branch["prerelease"].(type) {
+			case string:
+				bc.Prerelease = prerelease
+			case bool:
+				if prerelease {
+					bc.Prerelease = bc.Name
+				}
+			}
+			for key, value := range branch {
+				switch key {
+				case "name", "channel", "range", "prerelease":
+					continue
+				}
+				if bc.Extra == nil {
+					bc.Extra = make(map[string]any)
+				}
+				bc.Extra[key] = value
 			}
+			result = append(result, bc)
+		}
+	}
+	return result
+}
+
+// extractBranches returns the branch names.
+func extractBranches(branches []BranchConfig) []string {
+	var result []string
+	for _, b := range branches {
+		result = append(result, b.Name)
+	}
+	return result
+}
+
+// maintenanceBranchPattern matches semantic-release maintenance branch
+// names such as "1.x" or "1.2.x".
+var maintenanceBranchPattern = regexp.MustCompile(`^\d+(\.\d+)?\.x$`)
+
+// extractMaintenanceBranches extracts maintenance release lines from
+// semantic-release branches config. A branch is a maintenance branch when
+// it sets a range or its name looks like "N.x" / "N.N.x".
+func extractMaintenanceBranches(branches []BranchConfig) []MaintenanceBranch {
+	var result []MaintenanceBranch
+	for _, b := range branches {
+		mb := MaintenanceBranch{Name: b.Name, Range: b.Range, Channel: b.Channel}
+		if mb.Name == "" || (mb.Range == "" && !maintenanceBranchPattern.MatchString(mb.Name)) {
+			continue
+		}
+		if mb.Range == "" {
+			// semantic-release defaults the range to the branch name
+			mb.Range = mb.Name
 		}
+		result = append(result, mb)
 	}
 	return result
 }
 
 // convertSemanticReleasePlugins converts semantic-release plugins to Relicta plugins.
-func convertSemanticReleasePlugins(plugins []any) []PluginConfig {
+func convertSemanticReleasePlugins(plugins []any, conv *Conversion) []PluginConfig {
 	var result []PluginConfig
 
 	for _, p := range plugins {
@@ -279,18 +1208,156 @@ func convertSemanticReleasePlugins(plugins []any) []PluginConfig {
 			}
 		}
 
+		// Analyzer and notes plugins have no Relicta plugin, but their
+		// preset settings carry over to versioning and changelog
+		switch strings.TrimPrefix(pluginName, "@semantic-release/") {
+		case "commit-analyzer", "release-notes-generator":
+			notes := strings.HasSuffix(pluginName, "release-notes-generator")
+			convertSemanticReleasePreset("plugins["+pluginName+"].", notes, pluginConfig, conv)
+		}
+
+		// Exec commands become hooks rather than a plugin
+		if strings.TrimPrefix(pluginName, "@semantic-release/") == "exec" {
+			if hooks := convertSemanticReleaseExec(pluginConfig, conv); len(hooks) > 0 {
+				conv.Config.Hooks = append(conv.Config.Hooks, hooks...)
+				conv.mapped("hooks", "plugins["+pluginName+"]")
+				continue
+			}
+		}
+
 		// Map semantic-release plugins to Relicta plugins
 		relictaPlugin := mapSemanticReleasePlugin(pluginName, pluginConfig)
 		if relictaPlugin != nil {
+			conv.mapped("plugins."+relictaPlugin.Name, "plugins["+pluginName+"]")
+			if !relictaPlugin.Enabled {
+				conv.warn("plugins", "%s requires manual migration", pluginName)
+			}
 			result = append(result, *relictaPlugin)
 		}
 	}
 
+	sort.SliceStable(conv.Config.Hooks, func(i, j int) bool {
+		return execPhaseIndex(conv.Config.Hooks[i].Phase) < execPhaseIndex(conv.Config.Hooks[j].Phase)
+	})
+
 	return result
 }
 
-// mapSemanticReleasePlugin maps a semantic-release plugin to Relicta equivalent.
+// execPhases lists the @semantic-release/exec command options in the order
+// semantic-release runs its lifecycle steps, with the Relicta hook phase
+// each one maps to.
+var execPhases = []struct {
+	option string
+	phase  string
+}{
+	{"verifyConditionsCmd", "verify_conditions"},
+	{"analyzeCommitsCmd", "analyze_commits"},
+	{"verifyReleaseCmd", "verify_release"},
+	{"generateNotesCmd", "generate_notes"},
+	{"prepareCmd", "prepare"},
+	{"addChannelCmd", "add_channel"},
+	{"publishCmd", "publish"},
+	{"successCmd", "success"},
+	{"failCmd", "fail"},
+}
+
+// execPhaseIndex returns the lifecycle position of a hook phase.
+func execPhaseIndex(phase string) int {
+	for i, p := range execPhases {
+		if p.phase == phase {
+			return i
+		}
+	}
+	return len(execPhases)
+}
+
+// convertSemanticReleaseExec converts @semantic-release/exec commands into
+// hooks ordered by lifecycle phase. Options other than the commands are
+// reported since Relicta hooks have no equivalent.
+func convertSemanticReleaseExec(config map[string]any, conv *Conversion) []HookConfig {
+	var hooks []HookConfig
+	known := make(map[string]bool, len(execPhases))
+	for _, p := range execPhases {
+		known[p.option] = true
+		if cmd, ok := config[p.option].(string); ok && cmd != "" {
+			hooks = append(hooks, HookConfig{Phase: p.phase, Command: convertTemplate(cmd)})
+		}
+	}
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	var extra []string
+	for key := range config {
+		if !known[key] {
+			extra = append(extra, key)
+		}
+	}
+	sort.Strings(extra)
+	for _, key := range extra {
+		conv.warn("hooks", "@semantic-release/exec option %q is not supported", key)
+	}
+
+	return hooks
+}
+
+// convertSemanticReleasePreset maps the preset, presetConfig.types and
+// releaseRules options of commit-analyzer and release-notes-generator, or
+// the same options at the top level of the config, where semantic-release
+// passes them to every plugin. source prefixes the option names in the
+// provenance. Settings already converted win, so plugin options must be
+// converted before the top-level ones; sections from release-notes-generator
+// (notes) win over commit-analyzer's since it renders the notes.
+func convertSemanticReleasePreset(source string, notes bool, config map[string]any, conv *Conversion) {
+	if config == nil {
+		return
+	}
+
+	if preset, ok := config["preset"].(string); ok && conv.Config.Changelog.Preset == "" {
+		conv.Config.Changelog.Preset = mapChangelogPreset(preset, conv)
+		conv.mapped("changelog.preset", source+"preset")
+	}
+
+	if presetConfig, ok := config["presetConfig"].(map[string]any); ok {
+		if types, ok := presetConfig["types"].([]any); ok {
+			sections := convertChangelogTypes(types)
+			if len(sections) > 0 && (notes || len(conv.Config.Changelog.Sections) == 0) {
+				conv.Config.Changelog.Sections = sections
+				conv.mapped("changelog.sections", source+"presetConfig.types")
+			}
+		}
+	}
+
+	if rules, ok := config["releaseRules"].([]any); ok && len(conv.Config.Versioning.ReleaseRules) == 0 {
+		for _, r := range rules {
+			rule, ok := r.(map[string]any)
+			if !ok {
+				continue
+			}
+			typ, _ := rule["type"].(string)
+			release, _ := rule["release"].(string)
+			if typ == "" || release == "" {
+				conv.warn("releaseRules", "rule %v cannot be converted; only type-based rules are supported", rule)
+				continue
+			}
+			conv.Config.Versioning.ReleaseRules = append(conv.Config.Versioning.ReleaseRules, ReleaseRule{
+				Type:    typ,
+				Release: release,
+			})
+		}
+		if len(conv.Config.Versioning.ReleaseRules) > 0 {
+			conv.mapped("versioning.release_rules", source+"releaseRules")
+		}
+	}
+}
+
+// mapSemanticReleasePlugin maps a semantic-release plugin to Relicta
+// equivalent. Registered plugin mappings are consulted first.
 func mapSemanticReleasePlugin(name string, config map[string]any) *PluginConfig {
+	if m, ok := pluginMappings[name]; ok {
+		return m.plugin(config)
+	}
+
 	// Normalize plugin name
 	name = strings.TrimPrefix(name, "@semantic-release/")
 
@@ -305,13 +1372,13 @@ func mapSemanticReleasePlugin(name string, config map[string]any) *PluginConfig
 		return &PluginConfig{
 			Name:    "gitlab",
 			Enabled: true,
-			Config:  config,
+			Config:  convertGitLabPluginConfig(config),
 		}
 	case "npm":
 		return &PluginConfig{
 			Name:    "npm",
 			Enabled: true,
-			Config:  config,
+			Config:  renameKeys(config, npmPluginKeys),
 		}
 	case "changelog", "release-notes-generator":
 		// Handled by Relicta core, not a plugin
@@ -328,7 +1395,7 @@ func mapSemanticReleasePlugin(name string, config map[string]any) *PluginConfig
 			Name:    "custom",
 			Enabled: false,
 			Config: map[string]any{
-				"_note":    "Migrate custom exec commands manually",
+				"_note":     "Migrate custom exec commands manually",
 				"_original": config,
 			},
 		}
@@ -338,27 +1405,121 @@ func mapSemanticReleasePlugin(name string, config map[string]any) *PluginConfig
 			Name:    name,
 			Enabled: false,
 			Config: map[string]any{
-				"_note":    "Unknown plugin - requires manual migration",
+				"_note":     "Unknown plugin - requires manual migration",
 				"_original": config,
 			},
 		}
 	}
 }
 
-// convertTemplate converts template syntax from other tools to Relicta format.
+// npmPluginKeys maps @semantic-release/npm options to Relicta npm plugin keys.
+var npmPluginKeys = map[string]string{
+	"pkgRoot":    "package_root",
+	"tarballDir": "tarball_dir",
+	"npmPublish": "publish",
+}
+
+// gitlabPluginKeys maps @semantic-release/gitlab options to Relicta gitlab
+// plugin keys.
+var gitlabPluginKeys = map[string]string{
+	"gitlabUrl":           "host",
+	"gitlabApiPathPrefix": "api_path_prefix",
+}
+
+// gitlabAssetKeys maps @semantic-release/gitlab asset object keys to
+// Relicta asset keys.
+var gitlabAssetKeys = map[string]string{
+	"filepath": "file_path",
+}
+
+// convertGitLabPluginConfig renames @semantic-release/gitlab options and
+// normalizes its assets. An asset is either a glob or an object with path,
+// label, type and filepath; objects with only a path become a plain glob.
+// milestones are kept as-is.
+func convertGitLabPluginConfig(config map[string]any) map[string]any {
+	result := renameKeys(config, gitlabPluginKeys)
+	if host, ok := result["host"].(string); ok {
+		result["host"] = strings.TrimSuffix(host, "/")
+	}
+
+	assets, ok := result["assets"].([]any)
+	if !ok {
+		return result
+	}
+	normalized := make([]any, 0, len(assets))
+	for _, a := range assets {
+		switch asset := a.(type) {
+		case string:
+			normalized = append(normalized, asset)
+		case map[string]any:
+			if path, ok := asset["path"]; ok && len(asset) == 1 {
+				normalized = append(normalized, path)
+				continue
+			}
+			normalized = append(normalized, renameKeys(asset, gitlabAssetKeys))
+		}
+	}
+	result["assets"] = normalized
+	return result
+}
+
+// renameKeys returns a copy of config with keys renamed according to
+// names. Keys without a mapping are kept unchanged.
+func renameKeys(config map[string]any, names map[string]string) map[string]any {
+	if config == nil {
+		return nil
+	}
+	result := make(map[string]any, len(config))
+	for k, v := range config {
+		if renamed, ok := names[k]; ok {
+			k = renamed
+		}
+		result[k] = v
+	}
+	return result
+}
+
+// templateVarPattern matches a ${name} (semantic-release, release-it) or
+// {{name}} (standard-version) template variable.
+var templateVarPattern = regexp.MustCompile(`\$\{\s*([\w.]+)\s*\}|\{\{\s*([\w.]+)\s*\}\}`)
+
+// templateVars maps source tool template variables to Relicta templates.
+var templateVars = map[string]string{
+	"version":             "{{.Version}}",
+	"nextRelease.version": "{{.Version}}",
+	"currentTag":          "{{.Tag}}",
+	"nextRelease.gitTag":  "{{.Tag}}",
+	"tagName":             "{{.Tag}}",
+	"changelog":           "{{.Changelog}}",
+}
+
+// convertTemplate converts template syntax from other tools to Relicta
+// format, e.g. ${version} and {{currentTag}}. Unknown variables are left
+// as they are.
 func convertTemplate(template string) string {
-	// ${version} -> {{.Version}}
-	template = strings.ReplaceAll(template, "${version}", "{{.Version}}")
-	// ${nextRelease.version} -> {{.Version}}
-	template = strings.ReplaceAll(template, "${nextRelease.version}", "{{.Version}}")
-	// {{version}} -> {{.Version}}
-	template = strings.ReplaceAll(template, "{{version}}", "{{.Version}}")
+	return templateVarPattern.ReplaceAllStringFunc(template, func(m string) string {
+		sub := templateVarPattern.FindStringSubmatch(m)
+		if relicta, ok := templateVars[sub[1]+sub[2]]; ok {
+			return relicta
+		}
+		return m
+	})
+}
 
-	return template
+// convertMessage converts a commit or tag message template. Line endings
+// are normalized and trailing whitespace is trimmed from each line, as git
+// does when committing, so a multi-line message is written as a YAML
+// block scalar with its line breaks intact.
+func convertMessage(message string) string {
+	lines := strings.Split(strings.ReplaceAll(message, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return convertTemplate(strings.Join(lines, "\n"))
 }
 
 // convertGoReleaser converts GoReleaser config to Relicta.
-func convertGoReleaser(result *detector.Result) (*RelictaConfig, error) {
+func convertGoReleaser(result *detector.Result) (*Conversion, error) {
 	data := result.ConfigData
 	config := &RelictaConfig{
 		Versioning: VersioningConfig{
@@ -373,41 +1534,82 @@ func convertGoReleaser(result *detector.Result) (*RelictaConfig, error) {
 			RequireCleanTree: true,
 			PushTags:         true,
 			CreateTag:        true,
-			AllowedBranches:  []string{"main"},
 		},
 	}
+	conv := newConversion(config)
+	conv.assume("versioning.tag_prefix", "GoReleaser tags are conventionally v-prefixed")
+	// The schema version decides how other keys are read below
+	if _, ok := data["version"]; ok {
+		conv.consume("version")
+	}
 
-	// Extract project name for reference
+	// GoReleaser has no branch setting; release from the repository's
+	// default branch
+	if branch, ok := result.Details["defaultBranch"].(string); ok && branch != "" {
+		config.Git.AllowedBranches = []string{branch}
+		conv.assume("git.allowed_branches", "default branch of the git repository")
+	} else {
+		config.Git.AllowedBranches = []string{"main"}
+		conv.assume("git.allowed_branches", "default branch, source tool did not specify")
+	}
+
+	// Extract project name
 	projectName := ""
 	if pn, ok := data["project_name"].(string); ok {
 		projectName = pn
 	}
+	if projectName != "" {
+		config.Project = &ProjectConfig{Name: projectName}
+		conv.mapped("project.name", "project_name")
+	}
 
-	// Extract changelog config
+	// Extract changelog config. GoReleaser v2 renamed changelog.skip to
+	// changelog.disable.
 	if changelog, ok := data["changelog"].(map[string]any); ok {
-		if skip, ok := changelog["skip"].(bool); ok && skip {
+		key := "skip"
+		if goReleaserSchema(data) >= 2 {
+			key = "disable"
+		}
+		if disable, ok := changelog[key].(bool); ok && disable {
 			config.Changelog.Enabled = false
+			conv.mapped("changelog.enabled", "changelog."+key)
+		}
+		if use, ok := changelog["use"].(string); ok && use != "" {
+			if goReleaserChangelogSources[use] {
+				config.Changelog.Source = use
+				conv.mapped("changelog.source", "changelog.use")
+			} else {
+				conv.warn("changelog.use", "changelog.use %q has no Relicta changelog source; set changelog.source manually", use)
+			}
 		}
 	}
 
 	// Extract release config
+	forge := "github"
 	if release, ok := data["release"].(map[string]any); ok {
+		forge = goReleaserForge(release)
 		ghConfig := PluginConfig{
-			Name:    "github",
+			Name:    forge,
 			Enabled: true,
 			Config:  make(map[string]any),
 		}
 
-		// Extract GitHub owner/repo if present
-		if github, ok := release["github"].(map[string]any); ok {
-			if owner, ok := github["owner"].(string); ok {
+		// Extract forge owner/repo if present
+		if repo, ok := release[forge].(map[string]any); ok {
+			if owner, ok := repo["owner"].(string); ok {
 				ghConfig.Config["owner"] = owner
 			}
-			if name, ok := github["name"].(string); ok {
+			if name, ok := repo["name"].(string); ok {
 				ghConfig.Config["repo"] = name
 			}
 		}
 
+		// Self-hosted GitLab and Gitea instances are configured through
+		// gitlab_urls/gitea_urls
+		if host := goReleaserForgeHost(data, forge); host != "" {
+			ghConfig.Config["host"] = host
+		}
+
 		// Extract draft setting
 		if draft, ok := release["draft"].(bool); ok {
 			ghConfig.Config["draft"] = draft
@@ -425,42 +1627,476 @@ func convertGoReleaser(result *detector.Result) (*RelictaConfig, error) {
 			ghConfig.Config["name_template"] = nameTemplate
 		}
 
+		// Extract how an existing release is updated
+		if mode, ok := release["mode"].(string); ok && mode != "" {
+			if relictaMode, ok := goReleaserReleaseModes[mode]; ok {
+				ghConfig.Config["mode"] = relictaMode
+			} else {
+				conv.warn("release.mode", "unknown release mode %q; set plugins.%s.config.mode manually", mode, forge)
+			}
+		}
+
+		// Extract whether a release is created and assets are uploaded.
+		// Both also accept a template, evaluated at release time.
+		switch disable := release["disable"].(type) {
+		case bool:
+			if disable {
+				ghConfig.Enabled = false
+				conv.mapped("plugins."+forge+".enabled", "release.disable")
+			}
+		case string:
+			conv.warn("release.disable", "release.disable template %q is evaluated at release time; set plugins.%s.enabled manually", disable, forge)
+		}
+		switch skipUpload := release["skip_upload"].(type) {
+		case bool:
+			if skipUpload {
+				ghConfig.Config["skip_upload"] = true
+			}
+		case string:
+			conv.warn("release.skip_upload", "release.skip_upload template %q is evaluated at release time; set plugins.%s.config.skip_upload manually", skipUpload, forge)
+		}
+
+		// Extract release notes header and footer
+		if header, ok := goReleaserNotesText(release, "header", result.ConfigFile, conv); ok {
+			config.Changelog.Header = header
+			conv.mapped("changelog.header", "release.header")
+		}
+		if footer, ok := goReleaserNotesText(release, "footer", result.ConfigFile, conv); ok {
+			config.Changelog.Footer = footer
+			conv.mapped("changelog.footer", "release.footer")
+		}
+
 		config.Plugins = append(config.Plugins, ghConfig)
+		conv.mapped("plugins."+forge, "release")
 	} else {
 		// Default GitHub plugin if no release config
 		config.Plugins = append(config.Plugins, PluginConfig{
 			Name:    "github",
 			Enabled: true,
 		})
+		conv.assume("plugins.github", "default, GoReleaser publishes GitHub releases")
+	}
+
+	// Tag sorting has no Relicta setting; keep it visible in a note
+	if git, ok := data["git"].(map[string]any); ok {
+		if tagSort, ok := git["tag_sort"].(string); ok && tagSort != "" {
+			config.Versioning.Note = fmt.Sprintf("GoReleaser sorted tags with git.tag_sort %q to find the previous release; Relicta uses the highest semver tag with the tag prefix.", tagSort)
+			conv.mapped("versioning._note", "git.tag_sort")
+		}
 	}
 
 	// Extract build targets for assets config
-	assets := extractGoReleaserAssets(data, projectName)
+	keepGoarch, _ := result.Details["keepGoarch"].(bool)
+	assets := extractGoReleaserAssets(data, projectName, !keepGoarch)
 	if len(assets) > 0 {
 		for i := range config.Plugins {
-			if config.Plugins[i].Name == "github" {
+			if config.Plugins[i].Name == forge {
 				if config.Plugins[i].Config == nil {
 					config.Plugins[i].Config = make(map[string]any)
 				}
 				config.Plugins[i].Config["assets"] = assets
+				if builds, _ := data["builds"].([]any); len(builds) > 0 {
+					conv.mapped("plugins."+forge+".config.assets", "builds")
+				} else {
+					conv.assume("plugins."+forge+".config.assets", "GoReleaser's default build targets, no builds configured")
+				}
+				break
+			}
+		}
+	}
+
+	// Extra files bundled into the archives, such as completions and
+	// man pages
+	if files := goReleaserArchiveFiles(data); len(files) > 0 {
+		for i := range config.Plugins {
+			if config.Plugins[i].Name == forge {
+				if config.Plugins[i].Config == nil {
+					config.Plugins[i].Config = make(map[string]any)
+				}
+				config.Plugins[i].Config["archive_files"] = files
+				conv.mapped("plugins."+forge+".config.archive_files", "archives[].files")
+				break
+			}
+		}
+	}
+
+	// Milestones closed on release belong to the forge plugin
+	if milestones := goReleaserMilestones(data); len(milestones) > 0 {
+		for i := range config.Plugins {
+			if config.Plugins[i].Name == forge {
+				if config.Plugins[i].Config == nil {
+					config.Plugins[i].Config = make(map[string]any)
+				}
+				config.Plugins[i].Config["milestones"] = milestones
+				conv.mapped("plugins."+forge+".config.milestones", "milestones")
 				break
 			}
 		}
 	}
 
-	// Extract snapshot config for version template reference
+	// Carry the checksum algorithm over to the checksum plugin
+	if checksum, ok := data["checksum"].(map[string]any); ok {
+		if algorithm, ok := checksum["algorithm"].(string); ok && algorithm != "" {
+			if name := goReleaserChecksumName(data, projectName); name != "" {
+				config.Plugins = append(config.Plugins, PluginConfig{
+					Name:    "checksum",
+					Enabled: true,
+					Config: map[string]any{
+						"algorithm": algorithm,
+						"file":      name,
+					},
+				})
+				conv.mapped("plugins.checksum", "checksum")
+			}
+		}
+	}
+
+	// SBOM generation has no Relicta plugin yet; keep its settings in a
+	// disabled entry so it is not lost
+	if sboms, ok := data["sboms"].([]any); ok && len(sboms) > 0 {
+		config.Plugins = append(config.Plugins, convertGoReleaserSBOMs(sboms))
+		conv.mapped("plugins.sbom", "sboms")
+		conv.warn("plugins", "sboms: Relicta has no SBOM plugin; re-add SBOM generation to your release pipeline")
+	}
+
+	// Container images built with ko need no Dockerfile
+	if kos, ok := data["kos"].([]any); ok && len(kos) > 0 {
+		if ko, ok := convertGoReleaserKos(kos); ok {
+			config.Plugins = append(config.Plugins, ko)
+			conv.mapped("plugins.ko", "kos")
+		}
+	}
+
+	// Relicta does not build binaries, but the build environment, flags
+	// and ldflags (often injecting version metadata) and the timestamps
+	// that make builds reproducible must not be lost
+	if build, ok := convertGoReleaserBuild(data, conv); ok {
+		config.Plugins = append(config.Plugins, build)
+		if build.Config["metadata"] != nil {
+			conv.warn("plugins", "metadata.mod_timestamp: GoReleaser set the modification time of its metadata files for reproducible builds; keep setting it in your build step or releases are no longer bit-for-bit reproducible")
+		}
+		conv.warn("plugins.goreleaser-build", "Relicta does not build binaries; reproduce the build settings kept here in your build step")
+	}
+
+	// Extract snapshot (nightly) version template. GoReleaser v2 renamed
+	// name_template to version_template.
 	if snapshot, ok := data["snapshot"].(map[string]any); ok {
-		if versionTemplate, ok := snapshot["version_template"].(string); ok {
-			// Store for reference - Relicta handles this differently
-			_ = versionTemplate
+		for _, key := range []string{"version_template", "name_template"} {
+			if versionTemplate, ok := snapshot[key].(string); ok {
+				config.Versioning.SnapshotTemplate = convertGoReleaserTemplate(versionTemplate)
+				conv.mapped("versioning.snapshot_template", "snapshot."+key)
+				// A date-based version means the project uses CalVer
+				if format, ok := calVerFromTemplate(versionTemplate); ok {
+					config.Versioning.Strategy = "calver"
+					config.Versioning.CalVerFormat = format
+					conv.mapped("versioning.strategy", "snapshot."+key)
+					conv.mapped("versioning.calver_format", "snapshot."+key)
+				}
+				break
+			}
 		}
 	}
 
-	return config, nil
+	return conv, nil
 }
 
-// extractGoReleaserAssets generates asset patterns from GoReleaser build config.
-func extractGoReleaserAssets(data map[string]any, projectName string) []string {
+// goReleaserKoKeys lists the kos entry settings that are preserved. id
+// tells entries apart; repositories is the list form of repository.
+var goReleaserKoKeys = []string{"id", "repository", "repositories", "tags", "platforms", "base_image"}
+
+// convertGoReleaserKos builds a ko plugin from GoReleaser's kos section,
+// converting the templates in tags and repositories. A single entry is
+// stored flat; several are kept as a list under "kos". It reports false
+// when no entry holds a preserved setting.
+func convertGoReleaserKos(kos []any) (PluginConfig, bool) {
+	var entries []map[string]any
+	for _, k := range kos {
+		ko, ok := k.(map[string]any)
+		if !ok {
+			continue
+		}
+		entry := make(map[string]any)
+		for _, key := range goReleaserKoKeys {
+			if value, ok := ko[key]; ok {
+				entry[key] = convertGoReleaserValue(value)
+			}
+		}
+		if len(entry) > 0 {
+			entries = append(entries, entry)
+		}
+	}
+	if len(entries) == 0 {
+		return PluginConfig{}, false
+	}
+
+	plugin := PluginConfig{
+		Name:    "ko",
+		Enabled: true,
+		Config:  make(map[string]any),
+	}
+	if len(entries) == 1 {
+		plugin.Config = entries[0]
+	} else {
+		plugin.Config["kos"] = entries
+	}
+	return plugin, true
+}
+
+// goReleaserBuildKeys lists the builds entry settings that shape the
+// produced binaries. id and binary are kept to tell entries apart.
+var goReleaserBuildKeys = []string{"id", "binary", "env", "flags", "ldflags", "mod_timestamp"}
+
+// convertGoReleaserBuild builds a disabled goreleaser-build plugin from
+// the builds entries that set env, flags, ldflags or mod_timestamp, and
+// from the top-level gomod, metadata.mod_timestamp and report_sizes
+// settings. A single entry is stored flat; several are kept as a list
+// under "builds". Each source key gets its own provenance, and builds
+// only when an entry was kept. It reports false when there is nothing to
+// keep.
+func convertGoReleaserBuild(data map[string]any, conv *Conversion) (PluginConfig, bool) {
+	var entries []map[string]any
+	builds, _ := data["builds"].([]any)
+	for _, b := range builds {
+		build, ok := b.(map[string]any)
+		if !ok {
+			continue
+		}
+		entry := make(map[string]any)
+		for _, key := range goReleaserBuildKeys {
+			if value, ok := build[key]; ok {
+				entry[key] = convertGoReleaserValue(value)
+			}
+		}
+		if entry["env"] == nil && entry["flags"] == nil && entry["ldflags"] == nil && entry["mod_timestamp"] == nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	gomod, _ := data["gomod"].(map[string]any)
+	metadata, _ := data["metadata"].(map[string]any)
+	modTimestamp, _ := metadata["mod_timestamp"].(string)
+	reportSizes, _ := data["report_sizes"].(bool)
+	if len(entries) == 0 && len(gomod) == 0 && modTimestamp == "" && !reportSizes {
+		return PluginConfig{}, false
+	}
+
+	plugin := PluginConfig{
+		Name:    "goreleaser-build",
+		Enabled: false,
+		Config: map[string]any{
+			"_note": "Converted from GoReleaser build settings; Relicta does not build binaries, reproduce these settings in your build step",
+		},
+	}
+	switch len(entries) {
+	case 0:
+	case 1:
+		for key, value := range entries[0] {
+			plugin.Config[key] = value
+		}
+	default:
+		plugin.Config["builds"] = entries
+	}
+	if len(entries) > 0 {
+		conv.mapped("plugins.goreleaser-build", "builds")
+	}
+	if len(gomod) > 0 {
+		plugin.Config["gomod"] = convertGoReleaserValue(gomod)
+		conv.mapped("plugins.goreleaser-build.config.gomod", "gomod")
+	}
+	if modTimestamp != "" {
+		plugin.Config["metadata"] = map[string]any{"mod_timestamp": convertGoReleaserTemplate(modTimestamp)}
+		conv.mapped("plugins.goreleaser-build.config.metadata", "metadata.mod_timestamp")
+	}
+	if reportSizes {
+		plugin.Config["report_sizes"] = true
+		conv.mapped("plugins.goreleaser-build.config.report_sizes", "report_sizes")
+	}
+	return plugin, true
+}
+
+// convertGoReleaserValue converts the GoReleaser templates in a setting,
+// descending into lists and maps.
+func convertGoReleaserValue(value any) any {
+	switch v := value.(type) {
+	case string:
+		return convertGoReleaserTemplate(v)
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = convertGoReleaserValue(item)
+		}
+		return out
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			out[key] = convertGoReleaserValue(item)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// goReleaserSBOMKeys lists the sboms entry settings that are preserved.
+var goReleaserSBOMKeys = []string{"id", "cmd", "args", "artifacts", "documents", "env"}
+
+// sbomPlaceholderPattern matches GoReleaser's $artifact, ${document} and
+// ${document0} style placeholders in sboms commands.
+var sbomPlaceholderPattern = regexp.MustCompile(`\$\{?(artifact|document)(\d*)\}?`)
+
+// convertGoReleaserSBOMs builds a disabled sbom plugin from GoReleaser's
+// sboms section. A single entry is stored flat; several are kept as a list
+// under "sboms".
+func convertGoReleaserSBOMs(sboms []any) PluginConfig {
+	var entries []map[string]any
+	for _, s := range sboms {
+		sbom, ok := s.(map[string]any)
+		if !ok {
+			continue
+		}
+		entry := make(map[string]any)
+		for _, key := range goReleaserSBOMKeys {
+			if value, ok := sbom[key]; ok {
+				entry[key] = convertSBOMValue(value)
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	plugin := PluginConfig{
+		Name:    "sbom",
+		Enabled: false,
+		Config: map[string]any{
+			"_note": "Converted from GoReleaser sboms; Relicta has no SBOM plugin, re-add SBOM generation before enabling",
+		},
+	}
+	if len(entries) == 1 {
+		for key, value := range entries[0] {
+			plugin.Config[key] = value
+		}
+	} else {
+		plugin.Config["sboms"] = entries
+	}
+	return plugin
+}
+
+// convertSBOMValue normalizes the templates and placeholders in an sboms
+// setting, descending into lists.
+func convertSBOMValue(value any) any {
+	switch v := value.(type) {
+	case string:
+		return sbomPlaceholderPattern.ReplaceAllStringFunc(convertGoReleaserTemplate(v), func(m string) string {
+			sub := sbomPlaceholderPattern.FindStringSubmatch(m)
+			name := strings.ToUpper(sub[1][:1]) + sub[1][1:]
+			if sub[2] != "" {
+				return "{{index ." + name + "s " + sub[2] + "}}"
+			}
+			return "{{." + name + "}}"
+		})
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			out[i] = convertSBOMValue(item)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// goReleaserNotesText returns the release notes header or footer. GoReleaser
+// accepts an inline template, or from_file (read relative to the config
+// file) and from_url references.
+func goReleaserNotesText(release map[string]any, key, configFile string, conv *Conversion) (string, bool) {
+	field := "release." + key
+	switch v := release[key].(type) {
+	case string:
+		return convertGoReleaserTemplate(v), v != ""
+	case map[string]any:
+		if from, ok := v["from_file"].(map[string]any); ok {
+			file, _ := from["path"].(string)
+			if file == "" {
+				return "", false
+			}
+			if !filepath.IsAbs(file) {
+				file = filepath.Join(filepath.Dir(configFile), file)
+			}
+			data, err := os.ReadFile(file)
+			if err != nil {
+				conv.warn(field, "cannot read %s: %v; copy its contents into changelog.%s manually", file, err, key)
+				return "", false
+			}
+			return convertGoReleaserTemplate(string(data)), true
+		}
+		if from, ok := v["from_url"].(map[string]any); ok {
+			conv.warn(field, "%v is fetched at release time by GoReleaser; copy its contents into changelog.%s manually", from["url"], key)
+		}
+	}
+	return "", false
+}
+
+// goReleaserForge returns the forge a GoReleaser release section targets:
+// "gitlab", "gitea" or the default "github".
+func goReleaserForge(release map[string]any) string {
+	for _, forge := range []string{"gitlab", "gitea"} {
+		if _, ok := release[forge].(map[string]any); ok {
+			return forge
+		}
+	}
+	return "github"
+}
+
+// goReleaserForgeHost returns the base URL of a self-hosted forge from
+// GoReleaser's <forge>_urls section, or "" when none is configured.
+func goReleaserForgeHost(data map[string]any, forge string) string {
+	urls, ok := data[forge+"_urls"].(map[string]any)
+	if !ok {
+		return ""
+	}
+	if download, ok := urls["download"].(string); ok && download != "" {
+		return strings.TrimSuffix(download, "/")
+	}
+	if api, ok := urls["api"].(string); ok && api != "" {
+		api = strings.TrimSuffix(api, "/")
+		for _, suffix := range []string{"/api/v4", "/api/v1"} {
+			api = strings.TrimSuffix(api, suffix)
+		}
+		return api
+	}
+	return ""
+}
+
+// goReleaserActionPattern matches a GoReleaser template action such as
+// "{{ .Commit }}" or "{{ incpatch .Version }}".
+var goReleaserActionPattern = regexp.MustCompile(`\{\{-?\s*(.*?)\s*-?\}\}`)
+
+// goReleaserTemplateActions maps GoReleaser template actions to Relicta
+// template variables.
+var goReleaserTemplateActions = map[string]string{
+	"incpatch .Version": ".NextPatch",
+	"incminor .Version": ".NextMinor",
+	"incmajor .Version": ".NextMajor",
+	".FullCommit":       ".Commit",
+}
+
+// convertGoReleaserTemplate converts a GoReleaser template to Relicta
+// format, normalizing action whitespace and translating version helpers.
+func convertGoReleaserTemplate(template string) string {
+	return goReleaserActionPattern.ReplaceAllStringFunc(template, func(action string) string {
+		inner := goReleaserActionPattern.FindStringSubmatch(action)[1]
+		inner = strings.Join(strings.Fields(inner), " ")
+		if mapped, ok := goReleaserTemplateActions[inner]; ok {
+			inner = mapped
+		}
+		return "{{" + inner + "}}"
+	})
+}
+
+// extractGoReleaserAssets generates asset patterns from GoReleaser build
+// config. With normalize the architectures are named as the archives'
+// name_template names them (see goReleaserArchNames); without it the
+// GOARCH names are kept.
+func extractGoReleaserAssets(data map[string]any, projectName string, normalize bool) []string {
 	var assets []string
 
 	// Determine binary name
@@ -493,33 +2129,317 @@ func extractGoReleaserAssets(data map[string]any, projectName string) []string {
 		}
 	}
 
+	dist := goReleaserDist(data)
+	universal, replaceDarwin := goReleaserUniversal(data)
+	format, windowsFormat := goReleaserArchiveFormats(data)
+	archNames := map[string]string{}
+	if normalize {
+		archNames = goReleaserArchNames(data)
+	}
+
+	// extension returns the archive extension for os, or "" for the
+	// binary format, which uploads binaries unarchived
+	extension := func(os string) string {
+		ext := format
+		if os == "windows" {
+			ext = windowsFormat
+		}
+		if ext == "binary" {
+			return ""
+		}
+		return "." + ext
+	}
+
 	// Map to Relicta asset naming convention
 	for _, os := range goos {
+		if os == "darwin" && universal {
+			// A universal binary bundles every macOS architecture
+			assets = append(assets, path.Join(dist, fmt.Sprintf("%s_darwin_all%s", binaryName, extension(os))))
+			if replaceDarwin {
+				continue
+			}
+		}
 		for _, arch := range goarch {
 			// Convert to Relicta naming: plugin-name_os_arch
-			var archName string
-			switch arch {
-			case "amd64":
-				archName = "x86_64"
-			case "arm64":
-				archName = "aarch64"
-			default:
+			archName, ok := archNames[arch]
+			if !ok {
 				archName = arch
 			}
+			assets = append(assets, path.Join(dist, fmt.Sprintf("%s_%s_%s%s", binaryName, os, archName, extension(os))))
+		}
+	}
+
+	// Add the source archive
+	if source := goReleaserSourceArchive(data, projectName); source != "" {
+		assets = append(assets, path.Join(dist, source))
+	}
+
+	// Add checksums
+	if checksum := goReleaserChecksumName(data, projectName); checksum != "" {
+		assets = append(assets, path.Join(dist, checksum))
+	}
+
+	return assets
+}
 
-			ext := ".tar.gz"
-			if os == "windows" {
-				ext = ".zip"
+// goReleaserSourceArchive returns the file name of the source archive
+// GoReleaser builds when source.enabled is set, or "". The name follows
+// source.name_template (default "{{ .ProjectName }}-{{ .Version }}") and
+// source.format (default tar.gz). A known project name is substituted
+// into the template.
+func goReleaserSourceArchive(data map[string]any, projectName string) string {
+	source, _ := data["source"].(map[string]any)
+	if enabled, _ := source["enabled"].(bool); !enabled {
+		return ""
+	}
+	name, ok := source["name_template"].(string)
+	if !ok || name == "" {
+		name = "{{ .ProjectName }}-{{ .Version }}"
+	}
+	name = convertGoReleaserTemplate(name)
+	if projectName != "" {
+		name = strings.ReplaceAll(name, "{{.ProjectName}}", projectName)
+	}
+	format, ok := source["format"].(string)
+	if !ok || format == "" {
+		format = "tar.gz"
+	}
+	return name + "." + format
+}
+
+// goReleaserChecksumName returns the checksum file name from GoReleaser's
+// checksum section, or "" when checksums are disabled. A known project
+// name is substituted into the template.
+func goReleaserChecksumName(data map[string]any, projectName string) string {
+	checksum, _ := data["checksum"].(map[string]any)
+	if disable, ok := checksum["disable"].(bool); ok && disable {
+		return ""
+	}
+	name, ok := checksum["name_template"].(string)
+	if !ok || name == "" {
+		return "checksums.txt"
+	}
+	name = convertGoReleaserTemplate(name)
+	if projectName != "" {
+		name = strings.ReplaceAll(name, "{{.ProjectName}}", projectName)
+	}
+	return name
+}
+
+// goReleaserUniversal reports whether GoReleaser builds a macOS universal
+// binary and whether it replaces the per-architecture darwin binaries.
+func goReleaserUniversal(data map[string]any) (universal, replace bool) {
+	entries, ok := data["universal_binaries"].([]any)
+	if !ok || len(entries) == 0 {
+		return false, false
+	}
+	for _, entry := range entries {
+		if ub, ok := entry.(map[string]any); ok {
+			if r, ok := ub["replace"].(bool); ok && r {
+				return true, true
 			}
+		}
+	}
+	return true, false
+}
+
+// goReleaserReleaseModes maps GoReleaser's release.mode, which decides how
+// notes are written to a release that already exists, to the forge
+// plugin's mode.
+var goReleaserReleaseModes = map[string]string{
+	"append":        "append",
+	"prepend":       "prepend",
+	"replace":       "replace",
+	"keep-existing": "keep_existing",
+}
 
-			assets = append(assets, fmt.Sprintf("release/%s_%s_%s%s", binaryName, os, archName, ext))
+// goReleaserSchema returns the config schema version from GoReleaser's
+// top-level version key: 2 for v2 configs, 1 when it is absent.
+func goReleaserSchema(data map[string]any) int {
+	switch v := data["version"].(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	}
+	return 1
+}
+
+// goReleaserArchiveFormats returns the archive format of the first
+// archives entry and the one used for Windows. GoReleaser v2 lists
+// formats under formats (the singular format is still accepted there);
+// v1 only knows format. Without any setting archives are tar.gz, with
+// the usual zip override for Windows.
+func goReleaserArchiveFormats(data map[string]any) (format, windows string) {
+	format, windows = "tar.gz", "zip"
+	archives, _ := data["archives"].([]any)
+	if len(archives) == 0 {
+		return format, windows
+	}
+	archive, _ := archives[0].(map[string]any)
+
+	v2 := goReleaserSchema(data) >= 2
+	formatOf := func(m map[string]any) (string, bool) {
+		if v2 {
+			if list, ok := m["formats"].([]any); ok && len(list) > 0 {
+				if f, ok := list[0].(string); ok && f != "" {
+					return f, true
+				}
+			}
 		}
+		f, ok := m["format"].(string)
+		return f, ok && f != ""
 	}
 
-	// Add checksums
-	assets = append(assets, "release/checksums.txt")
+	if f, ok := formatOf(archive); ok {
+		format, windows = f, f
+	}
+	overrides, _ := archive["format_overrides"].([]any)
+	for _, o := range overrides {
+		override, _ := o.(map[string]any)
+		if override["goos"] != "windows" {
+			continue
+		}
+		if f, ok := formatOf(override); ok {
+			windows = f
+		}
+	}
+	return format, windows
+}
 
-	return assets
+// goReleaserArchiveFiles collects the files entries of all archives, in
+// order and without duplicates. A glob is kept as a string; an entry with
+// a destination or other settings is kept as a src/dst map.
+func goReleaserArchiveFiles(data map[string]any) []any {
+	archives, _ := data["archives"].([]any)
+	var files []any
+	seen := make(map[string]bool)
+	for _, a := range archives {
+		archive, _ := a.(map[string]any)
+		entries, _ := archive["files"].([]any)
+		for _, entry := range entries {
+			var file any
+			switch e := entry.(type) {
+			case string:
+				file = e
+			case map[string]any:
+				src, _ := e["src"].(string)
+				if src == "" {
+					continue
+				}
+				f := map[string]any{"src": src}
+				if dst, ok := e["dst"].(string); ok && dst != "" {
+					f["dst"] = dst
+				}
+				if strip, ok := e["strip_parent"].(bool); ok && strip {
+					f["strip_parent"] = true
+				}
+				if len(f) == 1 {
+					file = src
+				} else {
+					file = f
+				}
+			default:
+				continue
+			}
+			key := fmt.Sprint(file)
+			if !seen[key] {
+				seen[key] = true
+				files = append(files, file)
+			}
+		}
+	}
+	return files
+}
+
+// goReleaserChangelogSources lists the changelog.use values that name a
+// Relicta changelog source.
+var goReleaserChangelogSources = map[string]bool{
+	"git":           true,
+	"github":        true,
+	"github-native": true,
+	"gitlab":        true,
+	"gitea":         true,
+}
+
+// goReleaserMilestones converts the milestones entries: the repository
+// (when it is not the one released), whether to close the milestone, its
+// name template and whether a failure stops the release.
+func goReleaserMilestones(data map[string]any) []any {
+	entries, _ := data["milestones"].([]any)
+	var milestones []any
+	for _, e := range entries {
+		entry, ok := e.(map[string]any)
+		if !ok {
+			continue
+		}
+		milestone := make(map[string]any)
+		if repo, ok := entry["repo"].(map[string]any); ok {
+			if owner, ok := repo["owner"].(string); ok && owner != "" {
+				milestone["owner"] = owner
+			}
+			if name, ok := repo["name"].(string); ok && name != "" {
+				milestone["repo"] = name
+			}
+		}
+		if closeMilestone, ok := entry["close"].(bool); ok {
+			milestone["close"] = closeMilestone
+		}
+		if failOnError, ok := entry["fail_on_error"].(bool); ok && failOnError {
+			milestone["fail_on_error"] = true
+		}
+		if name, ok := entry["name_template"].(string); ok && name != "" {
+			milestone["name_template"] = convertGoReleaserTemplate(name)
+		}
+		if len(milestone) > 0 {
+			milestones = append(milestones, milestone)
+		}
+	}
+	return milestones
+}
+
+// relictaArchNames renames GOARCH values to Relicta's asset naming.
+var relictaArchNames = map[string]string{
+	"amd64": "x86_64",
+	"arm64": "aarch64",
+	"386":   "i386",
+}
+
+// goReleaserArchNames returns how the archives name each GOARCH that
+// differs from its Go name. An archives name_template decides: a GOARCH is
+// renamed only when the template spells out the Relicta name, as in the
+// common {{ if eq .Arch "amd64" }}x86_64{{ else }}{{ .Arch }}{{ end }}.
+// Without a template GoReleaser names archives with {{ .Arch }}, so every
+// GOARCH keeps its Go name.
+func goReleaserArchNames(data map[string]any) map[string]string {
+	archives, _ := data["archives"].([]any)
+	var archive map[string]any
+	if len(archives) > 0 {
+		archive, _ = archives[0].(map[string]any)
+	}
+	template, _ := archive["name_template"].(string)
+	names := make(map[string]string)
+	if template == "" {
+		return names
+	}
+	for goarch, name := range relictaArchNames {
+		if strings.Contains(template, name) {
+			names[goarch] = name
+		}
+	}
+	return names
+}
+
+// goReleaserDist returns GoReleaser's output directory as a slash-separated
+// path. GoReleaser writes to dist/ unless the dist key overrides it.
+func goReleaserDist(data map[string]any) string {
+	dist, ok := data["dist"].(string)
+	if !ok || strings.TrimSpace(dist) == "" {
+		return "dist"
+	}
+	// Asset patterns always use forward slashes, even for configs written
+	// on Windows
+	return path.Clean(strings.ReplaceAll(dist, "\\", "/"))
 }
 
 // toStringSlice converts []any to []string.
@@ -532,3 +2452,312 @@ func toStringSlice(input []any) []string {
 	}
 	return result
 }
+
+// releaseDrafterVersionVars are the version variables release-drafter
+// substitutes into its templates.
+var releaseDrafterVersionVars = []string{
+	"$RESOLVED_VERSION",
+	"$NEXT_MAJOR_VERSION",
+	"$NEXT_MINOR_VERSION",
+	"$NEXT_PATCH_VERSION",
+}
+
+// convertReleaseDrafter converts release-drafter config to Relicta.
+func convertReleaseDrafter(result *detector.Result) (*Conversion, error) {
+	data := result.ConfigData
+	config := &RelictaConfig{
+		Versioning: VersioningConfig{
+			Strategy: "conventional",
+			Note:     "release-drafter resolves versions from pull request labels; Relicta uses commit messages. Review release_rules and label your commits accordingly.",
+		},
+		Changelog: ChangelogConfig{
+			Enabled: true,
+			File:    "CHANGELOG.md",
+		},
+		Git: GitConfig{
+			RequireCleanTree: true,
+			PushTags:         true,
+			CreateTag:        true,
+		},
+	}
+	conv := newConversion(config)
+
+	// Extract tag prefix (e.g., "v$RESOLVED_VERSION" -> "v")
+	if tagTemplate, ok := data["tag-template"].(string); ok {
+		for _, v := range releaseDrafterVersionVars {
+			if strings.HasSuffix(tagTemplate, v) {
+				config.Versioning.TagPrefix = strings.TrimSuffix(tagTemplate, v)
+				conv.mapped("versioning.tag_prefix", "tag-template")
+				break
+			}
+		}
+	}
+
+	// Extract categories as changelog sections
+	if categories, ok := data["categories"].([]any); ok {
+		for _, c := range categories {
+			category, ok := c.(map[string]any)
+			if !ok {
+				continue
+			}
+			section := ChangelogSection{}
+			section.Title, _ = category["title"].(string)
+			if label, ok := category["label"].(string); ok {
+				section.Labels = append(section.Labels, label)
+			}
+			if labels, ok := category["labels"].([]any); ok {
+				section.Labels = append(section.Labels, toStringSlice(labels)...)
+			}
+			config.Changelog.Sections = append(config.Changelog.Sections, section)
+		}
+	}
+
+	if len(config.Changelog.Sections) > 0 {
+		conv.mapped("changelog.sections", "categories")
+	}
+
+	// Extract version-resolver labels as release rules
+	if resolver, ok := data["version-resolver"].(map[string]any); ok {
+		conv.mapped("versioning.release_rules", "version-resolver")
+		for _, release := range []string{"major", "minor", "patch"} {
+			level, ok := resolver[release].(map[string]any)
+			if !ok {
+				continue
+			}
+			labels, _ := level["labels"].([]any)
+			for _, label := range toStringSlice(labels) {
+				config.Versioning.ReleaseRules = append(config.Versioning.ReleaseRules, ReleaseRule{
+					Label:   label,
+					Release: release,
+				})
+			}
+		}
+	}
+
+	// release-drafter always drafts a GitHub release
+	ghConfig := PluginConfig{
+		Name:    "github",
+		Enabled: true,
+		Config: map[string]any{
+			"draft": true,
+		},
+	}
+	if nameTemplate, ok := data["name-template"].(string); ok {
+		ghConfig.Config["name_template"] = convertReleaseDrafterTemplate(nameTemplate)
+	}
+	config.Plugins = append(config.Plugins, ghConfig)
+	conv.mapped("plugins.github", "name-template")
+
+	conv.warn("version-resolver", "release-drafter is label-driven; version bumps will be derived from commits instead")
+
+	return conv, nil
+}
+
+// convertReleaseDrafterTemplate converts release-drafter $VARIABLE syntax to Relicta format.
+func convertReleaseDrafterTemplate(template string) string {
+	for _, v := range releaseDrafterVersionVars {
+		template = strings.ReplaceAll(template, v, "{{.Version}}")
+	}
+	return template
+}
+
+// mavenDefaultTagNameFormat is the maven-release-plugin's tag format when
+// tagNameFormat is not set.
+const mavenDefaultTagNameFormat = "@{project.artifactId}-@{project.version}"
+
+// mavenSCMPattern extracts the host, owner and repository from an SCM URL
+// such as scm:git:git@github.com:owner/repo.git or https://github.com/owner/repo.
+var mavenSCMPattern = regexp.MustCompile(`(github\.com|gitlab\.com)[:/]([^/]+)/([^/]+?)(?:\.git)?/?$`)
+
+// convertMavenRelease converts maven-release-plugin config to Relicta.
+func convertMavenRelease(result *detector.Result) (*Conversion, error) {
+	data := result.ConfigData
+	config := &RelictaConfig{
+		Versioning: VersioningConfig{
+			Strategy: "conventional",
+		},
+		Changelog: ChangelogConfig{
+			Enabled: true,
+			File:    "CHANGELOG.md",
+		},
+		Git: GitConfig{
+			RequireCleanTree: true,
+			PushTags:         true,
+			CreateTag:        true,
+		},
+	}
+	conv := newConversion(config)
+
+	// Extract tag format (e.g. "v@{project.version}" -> "v")
+	artifactID, _ := data["artifactId"].(string)
+	tagNameFormat, ok := data["tagNameFormat"].(string)
+	if ok {
+		conv.mapped("versioning.tag_prefix", "tagNameFormat")
+	} else {
+		tagNameFormat = mavenDefaultTagNameFormat
+		conv.assume("versioning.tag_prefix", "maven-release-plugin default tagNameFormat")
+	}
+	prefix := tagNameFormat
+	for _, v := range []string{"@{project.artifactId}", "${project.artifactId}"} {
+		prefix = strings.ReplaceAll(prefix, v, artifactID)
+	}
+	trimmed := strings.TrimSuffix(strings.TrimSuffix(prefix, "@{project.version}"), "${project.version}")
+	if trimmed == prefix {
+		conv.warn("tagNameFormat", "%q does not end with @{project.version}; set versioning.tag_prefix manually", tagNameFormat)
+	}
+	config.Versioning.TagPrefix = trimmed
+
+	if push, ok := data["pushChanges"].(bool); ok {
+		config.Git.PushTags = push
+		conv.mapped("git.push_tags", "pushChanges")
+	}
+
+	// Derive the release plugin from the SCM location
+	if scm, ok := data["scm"].(map[string]any); ok {
+		for _, key := range []string{"url", "developerConnection", "connection"} {
+			location, _ := scm[key].(string)
+			m := mavenSCMPattern.FindStringSubmatch(location)
+			if m == nil {
+				continue
+			}
+			name := strings.TrimSuffix(m[1], ".com")
+			config.Plugins = append(config.Plugins, PluginConfig{
+				Name:    name,
+				Enabled: true,
+				Config: map[string]any{
+					"owner": m[2],
+					"repo":  m[3],
+				},
+			})
+			conv.mapped("plugins."+name, "scm."+key)
+			break
+		}
+	}
+
+	return conv, nil
+}
+
+// changesetsDefaultChangelog is the changelog generator Changesets uses
+// when changelog is not set.
+const changesetsDefaultChangelog = "@changesets/cli/changelog"
+
+// convertChangesets converts Changesets config, and the prerelease state
+// in .changeset/pre.json when there is one, to Relicta.
+func convertChangesets(result *detector.Result) (*Conversion, error) {
+	data := result.ConfigData
+	config := &RelictaConfig{
+		Versioning: VersioningConfig{
+			Strategy:  "conventional",
+			TagPrefix: "v",
+			Note:      "Changesets takes version bumps from the changeset files in .changeset/; Relicta uses commit messages. Release any pending changesets before switching.",
+		},
+		Changelog: ChangelogConfig{
+			Enabled: true,
+			File:    "CHANGELOG.md",
+		},
+		Git: GitConfig{
+			RequireCleanTree: true,
+			PushTags:         true,
+			CreateTag:        true,
+		},
+	}
+	conv := newConversion(config)
+	conv.assume("versioning.tag_prefix", "Changesets tags single-package releases v<version>")
+	projectFromPackageJSON(result, conv)
+
+	if baseBranch, ok := data["baseBranch"].(string); ok && baseBranch != "" {
+		config.Git.AllowedBranches = []string{baseBranch}
+		conv.mapped("git.allowed_branches", "baseBranch")
+	} else if branch, ok := result.Details["defaultBranch"].(string); ok && branch != "" {
+		config.Git.AllowedBranches = []string{branch}
+		conv.assume("git.allowed_branches", "default branch of the git repository")
+	}
+
+	// changelog is false, a generator name or [generator, options]
+	switch changelog := data["changelog"].(type) {
+	case bool:
+		config.Changelog.Enabled = changelog
+		conv.mapped("changelog.enabled", "changelog")
+	case string, []any:
+		conv.mapped("changelog.enabled", "changelog")
+		generator, _ := changelog.(string)
+		if list, ok := changelog.([]any); ok && len(list) > 0 {
+			generator, _ = list[0].(string)
+		}
+		if generator != changesetsDefaultChangelog {
+			conv.warn("changelog", "changelog generator %s is not run by Relicta; the built-in changelog is generated instead", generator)
+		}
+	}
+
+	if access, ok := data["access"].(string); ok && access != "" {
+		config.Plugins = append(config.Plugins, PluginConfig{
+			Name:    "npm",
+			Enabled: true,
+			Config:  map[string]any{"access": access},
+		})
+		conv.mapped("plugins.npm", "access")
+	}
+
+	for _, key := range []string{"fixed", "linked"} {
+		if groups, ok := data[key].([]any); ok && len(groups) > 0 {
+			conv.warn(key, "%s package groups require manual migration", key)
+		}
+	}
+
+	if pre, ok := result.Details["pre"].(map[string]any); ok {
+		convertChangesetsPre(pre, conv)
+	}
+
+	return conv, nil
+}
+
+// convertChangesetsPre carries over the prerelease mode recorded in
+// .changeset/pre.json: the tag becomes the prerelease identifier, and the
+// state of the prerelease is described in the versioning note.
+func convertChangesetsPre(pre map[string]any, conv *Conversion) {
+	v := &conv.Config.Versioning
+	tag, _ := pre["tag"].(string)
+	switch mode, _ := pre["mode"].(string); mode {
+	case "pre":
+		if tag == "" {
+			conv.warn("pre.json", "prerelease mode has no tag; set versioning.prerelease manually")
+			return
+		}
+		v.Prerelease = tag
+		conv.mapped("versioning.prerelease", "pre.json tag")
+
+		note := fmt.Sprintf("Changesets was in prerelease mode on the %q tag, so releases are %s prereleases until you remove versioning.prerelease (changeset pre exit).", tag, tag)
+		if initial := changesetsInitialVersions(pre); initial != "" {
+			note += " Versions when prerelease mode was entered: " + initial + "."
+		}
+		if changesets, ok := pre["changesets"].([]any); ok && len(changesets) > 0 {
+			note += fmt.Sprintf(" %d changesets were already released as prereleases.", len(changesets))
+		}
+		v.Note += " " + note
+	case "exit":
+		v.Note += fmt.Sprintf(" Changesets was leaving prerelease mode on the %q tag (changeset pre exit), so the next release is stable.", tag)
+	default:
+		conv.warn("pre.json", "unknown prerelease mode %q", mode)
+		return
+	}
+	conv.mapped("versioning._note", "pre.json mode")
+}
+
+// changesetsInitialVersions lists the initialVersions of pre.json as
+// name@version, sorted by package name.
+func changesetsInitialVersions(pre map[string]any) string {
+	initial, _ := pre["initialVersions"].(map[string]any)
+	names := make([]string, 0, len(initial))
+	for name := range initial {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	versions := make([]string, 0, len(names))
+	for _, name := range names {
+		if version, ok := initial[name].(string); ok {
+			versions = append(versions, name+"@"+version)
+		}
+	}
+	return strings.Join(versions, ", ")
+}
@@ -1,6 +1,11 @@
 package converter
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/relicta-tech/migrate/internal/detector"
@@ -243,6 +248,13 @@ func TestConvertTemplate(t *testing.T) {
 		{"chore(release): ${version}", "chore(release): {{.Version}}"},
 		{"${nextRelease.version}", "{{.Version}}"},
 		{"{{version}}", "{{.Version}}"},
+		{"{{ version }}", "{{.Version}}"},
+		{"chore(release): {{currentTag}}", "chore(release): {{.Tag}}"},
+		{"release {{currentTag}} ({{version}})", "release {{.Tag}} ({{.Version}})"},
+		{"Release ${tagName}", "Release {{.Tag}}"},
+		{"{{previousTag}}", "{{previousTag}}"},
+		{"{{.Version}}", "{{.Version}}"},
+		{"${HOME}/bin", "${HOME}/bin"},
 		{"no template", "no template"},
 	}
 
@@ -418,6 +430,7 @@ func TestConvert_GoReleaser_Assets(t *testing.T) {
 		ConfigFile: ".goreleaser.yml",
 		ConfigData: map[string]any{
 			"project_name": "plugin-test",
+			"dist":         "release",
 			"builds": []any{
 				map[string]any{
 					"binary": "plugin-test",
@@ -458,12 +471,12 @@ func TestConvert_GoReleaser_Assets(t *testing.T) {
 
 	// Verify asset naming format
 	expectedPatterns := []string{
-		"release/plugin-test_linux_x86_64.tar.gz",
-		"release/plugin-test_linux_aarch64.tar.gz",
-		"release/plugin-test_darwin_x86_64.tar.gz",
-		"release/plugin-test_darwin_aarch64.tar.gz",
-		"release/plugin-test_windows_x86_64.zip",
-		"release/plugin-test_windows_aarch64.zip",
+		"release/plugin-test_linux_amd64.tar.gz",
+		"release/plugin-test_linux_arm64.tar.gz",
+		"release/plugin-test_darwin_amd64.tar.gz",
+		"release/plugin-test_darwin_arm64.tar.gz",
+		"release/plugin-test_windows_amd64.zip",
+		"release/plugin-test_windows_arm64.zip",
 		"release/checksums.txt",
 	}
 
@@ -481,6 +494,90 @@ func TestConvert_GoReleaser_Assets(t *testing.T) {
 	}
 }
 
+func TestConvert_GoReleaser_Schema(t *testing.T) {
+	builds := []any{
+		map[string]any{
+			"binary": "demo",
+			"goos":   []any{"linux", "windows"},
+			"goarch": []any{"amd64"},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		configData    map[string]any
+		wantChangelog bool
+		wantAssets    []string
+	}{
+		{
+			name: "v1 keys",
+			configData: map[string]any{
+				"builds":    builds,
+				"changelog": map[string]any{"skip": true},
+				"archives": []any{map[string]any{
+					"format":           "tar.xz",
+					"format_overrides": []any{map[string]any{"goos": "windows", "format": "zip"}},
+				}},
+			},
+			wantChangelog: false,
+			wantAssets:    []string{"dist/demo_linux_amd64.tar.xz", "dist/demo_windows_amd64.zip"},
+		},
+		{
+			name: "v2 keys",
+			configData: map[string]any{
+				"version":   2,
+				"builds":    builds,
+				"changelog": map[string]any{"disable": true},
+				"archives": []any{map[string]any{
+					"formats":          []any{"tar.zst"},
+					"format_overrides": []any{map[string]any{"goos": "windows", "formats": []any{"zip"}}},
+				}},
+			},
+			wantChangelog: false,
+			wantAssets:    []string{"dist/demo_linux_amd64.tar.zst", "dist/demo_windows_amd64.zip"},
+		},
+		{
+			name: "v2 ignores v1 skip",
+			configData: map[string]any{
+				"version":   2,
+				"builds":    builds,
+				"changelog": map[string]any{"skip": true},
+				"archives":  []any{map[string]any{"formats": []any{"binary"}}},
+			},
+			wantChangelog: true,
+			wantAssets:    []string{"dist/demo_linux_amd64", "dist/demo_windows_amd64"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv, err := ConvertDetailed(&detector.Result{
+				Tool:       detector.ToolGoReleaser,
+				ConfigFile: ".goreleaser.yaml",
+				ConfigData: tt.configData,
+			})
+			if err != nil {
+				t.Fatalf("ConvertDetailed() error = %v", err)
+			}
+			config := conv.Config
+
+			if config.Changelog.Enabled != tt.wantChangelog {
+				t.Errorf("Changelog.Enabled = %v, want %v", config.Changelog.Enabled, tt.wantChangelog)
+			}
+			assets := config.Plugins[0].Config["assets"].([]string)
+			if !reflect.DeepEqual(assets[:len(assets)-1], tt.wantAssets) {
+				t.Errorf("assets = %v, want %v plus checksums", assets, tt.wantAssets)
+			}
+			// The schema version is read, not reported as unmigrated
+			for _, key := range conv.UnmappedKeys(tt.configData) {
+				if key == "version" {
+					t.Errorf("UnmappedKeys() = %v, want version consumed", conv.UnmappedKeys(tt.configData))
+				}
+			}
+		})
+	}
+}
+
 func TestConvert_GoReleaser_GitSettings(t *testing.T) {
 	result := &detector.Result{
 		Tool:       detector.ToolGoReleaser,
@@ -506,6 +603,16 @@ func TestConvert_GoReleaser_GitSettings(t *testing.T) {
 	if len(config.Git.AllowedBranches) != 1 || config.Git.AllowedBranches[0] != "main" {
 		t.Errorf("AllowedBranches = %v, want [main]", config.Git.AllowedBranches)
 	}
+
+	// The repository's default branch replaces main
+	result.Details = map[string]any{"defaultBranch": "master"}
+	config, err = Convert(result)
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+	if len(config.Git.AllowedBranches) != 1 || config.Git.AllowedBranches[0] != "master" {
+		t.Errorf("AllowedBranches = %v, want [master]", config.Git.AllowedBranches)
+	}
 }
 
 func TestExtractGoReleaserAssets(t *testing.T) {
@@ -552,7 +659,7 @@ func TestExtractGoReleaserAssets(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assets := extractGoReleaserAssets(tt.data, tt.projectName)
+			assets := extractGoReleaserAssets(tt.data, tt.projectName, true)
 			if len(assets) != tt.wantCount {
 				t.Errorf("extractGoReleaserAssets() count = %v, want %v", len(assets), tt.wantCount)
 			}
@@ -605,7 +712,2185 @@ func TestConvert_UnsupportedTool(t *testing.T) {
 	}
 
 	_, err := Convert(result)
-	if err == nil {
-		t.Error("Convert() should return error for unsupported tool")
+	if !errors.Is(err, ErrUnsupportedTool) {
+		t.Errorf("Convert() error = %v, want ErrUnsupportedTool", err)
+	}
+}
+
+func TestConvertSemanticRelease_ExecHooks(t *testing.T) {
+	result := &detector.Result{
+		Tool: detector.ToolSemanticRelease,
+		ConfigData: map[string]any{
+			"plugins": []any{
+				[]any{"@semantic-release/exec", map[string]any{
+					"successCmd": "notify ${nextRelease.version}",
+					"publishCmd": "./publish.sh ${nextRelease.version}",
+					"failCmd":    "notify --failed",
+					"shell":      "/bin/bash",
+				}},
+				[]any{"@semantic-release/exec", map[string]any{
+					"verifyConditionsCmd": "./check.sh",
+					"prepareCmd":          "make build VERSION=${nextRelease.version}",
+				}},
+			},
+		},
+	}
+
+	conv, err := ConvertDetailed(result)
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+
+	want := []HookConfig{
+		{Phase: "verify_conditions", Command: "./check.sh"},
+		{Phase: "prepare", Command: "make build VERSION={{.Version}}"},
+		{Phase: "publish", Command: "./publish.sh {{.Version}}"},
+		{Phase: "success", Command: "notify {{.Version}}"},
+		{Phase: "fail", Command: "notify --failed"},
+	}
+	if !reflect.DeepEqual(conv.Config.Hooks, want) {
+		t.Errorf("Hooks = %+v, want %+v", conv.Config.Hooks, want)
+	}
+	if len(conv.Config.Plugins) != 0 {
+		t.Errorf("Plugins = %+v, want none", conv.Config.Plugins)
+	}
+	if len(conv.Warnings) != 1 || conv.Warnings[0].Field != "hooks" {
+		t.Errorf("Warnings = %v, want one for the shell option", conv.Warnings)
+	}
+	if conv.Provenance["hooks"] == "" {
+		t.Error("expected provenance for hooks")
+	}
+}
+
+func TestConvertDetailed_Warnings(t *testing.T) {
+	tests := []struct {
+		name         string
+		result       *detector.Result
+		wantWarnings int
+	}{
+		{
+			name: "known plugins only",
+			result: &detector.Result{
+				Tool: detector.ToolSemanticRelease,
+				ConfigData: map[string]any{
+					"plugins": []any{"@semantic-release/github", "@semantic-release/npm"},
+				},
+			},
+			wantWarnings: 0,
+		},
+		{
+			name: "exec and unknown plugins",
+			result: &detector.Result{
+				Tool: detector.ToolSemanticRelease,
+				ConfigData: map[string]any{
+					"plugins": []any{"@semantic-release/exec", "semantic-release-slack-bot"},
+				},
+			},
+			wantWarnings: 2,
+		},
+		{
+			name: "javascript config",
+			result: &detector.Result{
+				Tool:       detector.ToolReleaseIt,
+				ConfigFile: ".release-it.js",
+				ConfigData: map[string]any{"_jsConfig": true},
+			},
+			wantWarnings: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv, err := ConvertDetailed(tt.result)
+			if err != nil {
+				t.Fatalf("ConvertDetailed() error = %v", err)
+			}
+			if len(conv.Warnings) != tt.wantWarnings {
+				t.Errorf("Warnings = %v, want %d", conv.Warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestConvert_SemanticRelease_BranchObjects(t *testing.T) {
+	conv, err := ConvertDetailed(&detector.Result{
+		Tool: detector.ToolSemanticRelease,
+		ConfigData: map[string]any{"branches": []any{
+			"main",
+			map[string]any{"name": "next", "channel": "next", "deployEnv": "staging"},
+			map[string]any{"name": "beta", "prerelease": true},
+			map[string]any{"name": "1.x", "range": "1.x", "channel": false},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+
+	want := []BranchConfig{
+		{Name: "main"},
+		{Name: "next", Channel: "next", Extra: map[string]any{"deployEnv": "staging"}},
+		{Name: "beta", Prerelease: "beta"},
+		{Name: "1.x", Range: "1.x"},
+	}
+	if !reflect.DeepEqual(conv.Config.Git.Branches, want) {
+		t.Errorf("Branches = %+v, want %+v", conv.Config.Git.Branches, want)
+	}
+	if !reflect.DeepEqual(conv.Config.Git.AllowedBranches, []string{"main", "next", "beta", "1.x"}) {
+		t.Errorf("AllowedBranches = %v, want the branch names", conv.Config.Git.AllowedBranches)
+	}
+
+	// Plain names carry nothing beyond AllowedBranches
+	conv, err = ConvertDetailed(&detector.Result{
+		Tool:       detector.ToolSemanticRelease,
+		ConfigData: map[string]any{"branches": []any{"main", "next"}},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+	if conv.Config.Git.Branches != nil {
+		t.Errorf("Branches = %+v, want none for plain names", conv.Config.Git.Branches)
+	}
+}
+
+func TestExtractMaintenanceBranches(t *testing.T) {
+	branches := []any{
+		"main",
+		"1.x",
+		map[string]any{"name": "next", "prerelease": true},
+		map[string]any{"name": "2.x", "range": "2.x", "channel": "2.x"},
+		map[string]any{"name": "legacy", "range": ">=0.5.0 <1.0.0", "channel": "legacy"},
+	}
+
+	got := extractMaintenanceBranches(parseBranches(branches))
+	want := []MaintenanceBranch{
+		{Name: "1.x", Range: "1.x"},
+		{Name: "2.x", Range: "2.x", Channel: "2.x"},
+		{Name: "legacy", Range: ">=0.5.0 <1.0.0", Channel: "legacy"},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("extractMaintenanceBranches() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("extractMaintenanceBranches()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestConvert_SemanticRelease_PackageScopedTags(t *testing.T) {
+	workspace := map[string]any{"workspacePackage": true}
+	tests := []struct {
+		tagFormat  string
+		details    map[string]any
+		wantPrefix string
+		wantScope  string
+	}{
+		{"v${version}", nil, "v", ""},
+		{"my-pkg-v${version}", workspace, "my-pkg-v", "my-pkg"},
+		{"my-pkg-v${version}", map[string]any{"packageName": "my-pkg"}, "my-pkg-v", "my-pkg"},
+		{"@scope/pkg@${version}", nil, "@scope/pkg@", "@scope/pkg"},
+		{"pkg@${version}", nil, "pkg@", "pkg"},
+		{"tools/cli/v${version}", workspace, "tools/cli/v", "tools/cli"},
+		{"release${version}", nil, "release", ""},
+		{"release-${version}", nil, "release-", ""},
+		{"release-${version}", workspace, "release-", ""},
+		{"build-v${version}", nil, "build-v", ""},
+		{"my-pkg-v${version}", map[string]any{"packageName": "other"}, "my-pkg-v", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tagFormat, func(t *testing.T) {
+			config, err := Convert(&detector.Result{
+				Tool:       detector.ToolSemanticRelease,
+				ConfigData: map[string]any{"tagFormat": tt.tagFormat},
+				Details:    tt.details,
+			})
+			if err != nil {
+				t.Fatalf("Convert() error = %v", err)
+			}
+			if config.Versioning.TagPrefix != tt.wantPrefix {
+				t.Errorf("TagPrefix = %q, want %q", config.Versioning.TagPrefix, tt.wantPrefix)
+			}
+			if config.Versioning.PackageScope != tt.wantScope {
+				t.Errorf("PackageScope = %q, want %q", config.Versioning.PackageScope, tt.wantScope)
+			}
+		})
+	}
+}
+
+func TestConvert_SemanticRelease_Extends(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     map[string]any
+		wantText string
+	}{
+		{"extends only", map[string]any{"extends": "my-shared-config"}, "the real config is external"},
+		{"extends list", map[string]any{"extends": []any{"a-config", "b-config"}, "branches": []any{"main"}}, "a-config, b-config is not resolved"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv, err := ConvertDetailed(&detector.Result{Tool: detector.ToolSemanticRelease, ConfigData: tt.data})
+			if err != nil {
+				t.Fatalf("ConvertDetailed() error = %v", err)
+			}
+			if len(conv.Warnings) != 1 || conv.Warnings[0].Field != "extends" || !strings.Contains(conv.Warnings[0].Message, tt.wantText) {
+				t.Errorf("Warnings = %v, want one extends warning containing %q", conv.Warnings, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestConvert_SemanticRelease_TopLevelAssets(t *testing.T) {
+	assets := []any{"dist/*.tgz", map[string]any{"path": "build/app.zip", "label": "App"}}
+	tests := []struct {
+		name         string
+		plugins      []any
+		wantAssets   any
+		wantWarnings int
+	}{
+		{"no github plugin", nil, assets, 0},
+		{"github plugin", []any{"@semantic-release/github"}, assets, 0},
+		{"plugin assets win", []any{[]any{"@semantic-release/github", map[string]any{"assets": "out/*"}}}, "out/*", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := map[string]any{"assets": assets}
+			if tt.plugins != nil {
+				data["plugins"] = tt.plugins
+			}
+			conv, err := ConvertDetailed(&detector.Result{Tool: detector.ToolSemanticRelease, ConfigData: data})
+			if err != nil {
+				t.Fatalf("ConvertDetailed() error = %v", err)
+			}
+			if len(conv.Config.Plugins) != 1 || conv.Config.Plugins[0].Name != "github" {
+				t.Fatalf("Plugins = %v, want a single github plugin", conv.Config.Plugins)
+			}
+			if got := conv.Config.Plugins[0].Config["assets"]; !reflect.DeepEqual(got, tt.wantAssets) {
+				t.Errorf("assets = %v, want %v", got, tt.wantAssets)
+			}
+			if len(conv.Warnings) != tt.wantWarnings {
+				t.Errorf("Warnings = %v, want %d", conv.Warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestConvert_SemanticRelease_MaintenanceBranches(t *testing.T) {
+	result := &detector.Result{
+		Tool: detector.ToolSemanticRelease,
+		ConfigData: map[string]any{
+			"branches": []any{
+				"main",
+				map[string]any{"name": "1.x", "range": "1.x", "channel": "1.x"},
+			},
+		},
+	}
+
+	config, err := Convert(result)
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+
+	if len(config.Git.AllowedBranches) != 2 {
+		t.Errorf("AllowedBranches = %v, want [main 1.x]", config.Git.AllowedBranches)
+	}
+	if len(config.Git.MaintenanceBranches) != 1 || config.Git.MaintenanceBranches[0].Channel != "1.x" {
+		t.Errorf("MaintenanceBranches = %+v, want one 1.x branch", config.Git.MaintenanceBranches)
+	}
+}
+
+func TestConvert_ReleaseDrafter(t *testing.T) {
+	result := &detector.Result{
+		Tool:       detector.ToolReleaseDrafter,
+		ConfigFile: ".github/release-drafter.yml",
+		ConfigData: map[string]any{
+			"name-template": "Release $RESOLVED_VERSION",
+			"tag-template":  "v$RESOLVED_VERSION",
+			"categories": []any{
+				map[string]any{"title": "Features", "labels": []any{"feature", "enhancement"}},
+				map[string]any{"title": "Bug Fixes", "label": "bug"},
+			},
+			"version-resolver": map[string]any{
+				"major":   map[string]any{"labels": []any{"breaking"}},
+				"minor":   map[string]any{"labels": []any{"feature"}},
+				"patch":   map[string]any{"labels": []any{"bug", "chore"}},
+				"default": "patch",
+			},
+		},
+	}
+
+	conv, err := ConvertDetailed(result)
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+	config := conv.Config
+
+	if config.Versioning.TagPrefix != "v" {
+		t.Errorf("TagPrefix = %v, want v", config.Versioning.TagPrefix)
+	}
+	if config.Versioning.Note == "" {
+		t.Error("Versioning.Note should explain label-driven versioning")
+	}
+
+	if len(config.Changelog.Sections) != 2 {
+		t.Fatalf("Sections = %+v, want 2", config.Changelog.Sections)
+	}
+	if config.Changelog.Sections[0].Title != "Features" || len(config.Changelog.Sections[0].Labels) != 2 {
+		t.Errorf("Sections[0] = %+v, want Features with 2 labels", config.Changelog.Sections[0])
+	}
+	if len(config.Changelog.Sections[1].Labels) != 1 || config.Changelog.Sections[1].Labels[0] != "bug" {
+		t.Errorf("Sections[1] = %+v, want Bug Fixes with bug label", config.Changelog.Sections[1])
+	}
+
+	wantRules := []ReleaseRule{
+		{Label: "breaking", Release: "major"},
+		{Label: "feature", Release: "minor"},
+		{Label: "bug", Release: "patch"},
+		{Label: "chore", Release: "patch"},
+	}
+	if len(config.Versioning.ReleaseRules) != len(wantRules) {
+		t.Fatalf("ReleaseRules = %+v, want %+v", config.Versioning.ReleaseRules, wantRules)
+	}
+	for i, want := range wantRules {
+		if config.Versioning.ReleaseRules[i] != want {
+			t.Errorf("ReleaseRules[%d] = %+v, want %+v", i, config.Versioning.ReleaseRules[i], want)
+		}
+	}
+
+	if len(config.Plugins) != 1 || config.Plugins[0].Config["name_template"] != "Release {{.Version}}" {
+		t.Errorf("Plugins = %+v, want github with converted name_template", config.Plugins)
+	}
+	if len(conv.Warnings) == 0 {
+		t.Error("expected a warning about label-driven versioning")
+	}
+}
+
+func TestNewDefaultConfig(t *testing.T) {
+	config := NewDefaultConfig("release-", "trunk")
+
+	if config.Versioning.Strategy != "conventional" {
+		t.Errorf("Strategy = %q, want conventional", config.Versioning.Strategy)
+	}
+	if config.Versioning.TagPrefix != "release-" {
+		t.Errorf("TagPrefix = %q, want release-", config.Versioning.TagPrefix)
+	}
+	if !config.Changelog.Enabled {
+		t.Error("Changelog should be enabled")
+	}
+	if !reflect.DeepEqual(config.Git.AllowedBranches, []string{"trunk"}) {
+		t.Errorf("AllowedBranches = %v, want [trunk]", config.Git.AllowedBranches)
+	}
+	if len(config.Plugins) != 1 || config.Plugins[0].Name != "github" {
+		t.Errorf("Plugins = %v, want github", config.Plugins)
+	}
+}
+
+func TestConvertDetailed_Provenance(t *testing.T) {
+	result := &detector.Result{
+		Tool: detector.ToolReleaseIt,
+		ConfigData: map[string]any{
+			"git": map[string]any{
+				"tagName": "v${version}",
+				"push":    false,
+			},
+			"github": map[string]any{"release": true},
+		},
+	}
+
+	conv, err := ConvertDetailed(result)
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{"versioning.tag_prefix", "from git.tagName"},
+		{"git.push_tags", "from git.push"},
+		{"plugins.github", "from github"},
+		{"versioning.strategy", "default strategy, source tool did not specify"},
+		{"changelog.file", "default changelog file"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			if got := conv.Provenance[tt.field]; got != tt.want {
+				t.Errorf("Provenance[%q] = %q, want %q", tt.field, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConversion_Coverage(t *testing.T) {
+	conv := &Conversion{Provenance: map[string]string{
+		"versioning.tag_prefix": "from git.tagName",
+		"plugins.github":        "from github",
+		"changelog.file":        "default changelog file",
+		"git.push_tags":         "Relicta default",
+	}}
+
+	mapped, total := conv.Coverage()
+	if mapped != 2 || total != 4 {
+		t.Errorf("Coverage() = %d, %d, want 2, 4", mapped, total)
+	}
+}
+
+func TestConversion_Mappings(t *testing.T) {
+	conv := &Conversion{Provenance: map[string]string{
+		"versioning.tag_prefix": "from git.tagName",
+		"plugins.npm":           "from plugins[@semantic-release/npm]",
+		"changelog.file":        "default changelog file",
+	}}
+
+	want := []Mapping{
+		{Source: "plugins[@semantic-release/npm]", Field: "plugins.npm"},
+		{Source: "git.tagName", Field: "versioning.tag_prefix"},
+	}
+	if got := conv.Mappings(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Mappings() = %v, want %v", got, want)
+	}
+
+	for i, key := range []string{"plugins", "git"} {
+		if got := want[i].SourceKey(); got != key {
+			t.Errorf("SourceKey(%q) = %q, want %q", want[i].Source, got, key)
+		}
+	}
+}
+
+func TestConversion_SourceCoverage(t *testing.T) {
+	source := map[string]any{
+		"tagFormat": "v${version}",
+		"branches":  []any{"main"},
+		"plugins":   []any{"@semantic-release/github"},
+		"ci":        false,
+		"debug":     true,
+		"_comment":  "ignored",
+	}
+	conv, err := ConvertDetailed(&detector.Result{Tool: detector.ToolSemanticRelease, ConfigData: source})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+
+	mapped, total := conv.SourceCoverage(source)
+	if mapped != 3 || total != 5 {
+		t.Errorf("SourceCoverage() = %d, %d, want 3, 5", mapped, total)
+	}
+	if got := conv.UnmappedKeys(source); !reflect.DeepEqual(got, []string{"ci", "debug"}) {
+		t.Errorf("UnmappedKeys() = %v, want [ci debug]", got)
+	}
+}
+
+func TestConversion_Assumptions(t *testing.T) {
+	conv, err := ConvertDetailed(&detector.Result{
+		Tool:       detector.ToolGoReleaser,
+		ConfigData: map[string]any{"changelog": map[string]any{"skip": true}},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+	if err := conv.Set("git.push_tags", "false"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	got := make(map[string]any)
+	for _, a := range conv.Assumptions() {
+		got[a.Field] = a.Value
+	}
+	if !reflect.DeepEqual(got["git.allowed_branches"], []string{"main"}) {
+		t.Errorf("git.allowed_branches = %v, want [main]", got["git.allowed_branches"])
+	}
+	if v, ok := got["plugins.github"]; !ok || v != nil {
+		t.Errorf("plugins.github = %v, %v, want an assumption without a value", v, ok)
+	}
+	for _, field := range []string{"changelog.enabled", "git.push_tags"} {
+		if _, ok := got[field]; ok {
+			t.Errorf("Assumptions() includes %s, which was not defaulted", field)
+		}
+	}
+}
+
+func TestConvert_SemanticRelease_PresetConfig(t *testing.T) {
+	types := []any{
+		map[string]any{"type": "feat", "section": "Features"},
+		map[string]any{"type": "fix", "section": "Bug Fixes"},
+		map[string]any{"type": "perf", "section": "Bug Fixes"},
+		map[string]any{"type": "chore", "hidden": true},
+	}
+	result := &detector.Result{
+		Tool: detector.ToolSemanticRelease,
+		ConfigData: map[string]any{
+			"plugins": []any{
+				[]any{"@semantic-release/commit-analyzer", map[string]any{
+					"preset": "conventionalcommits",
+					"releaseRules": []any{
+						map[string]any{"type": "docs", "release": "patch"},
+						map[string]any{"breaking": true, "release": "major"},
+					},
+				}},
+				[]any{"@semantic-release/release-notes-generator", map[string]any{
+					"preset":       "conventionalcommits",
+					"presetConfig": map[string]any{"types": types},
+				}},
+			},
+		},
+	}
+
+	conv, err := ConvertDetailed(result)
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+	config := conv.Config
+
+	if config.Changelog.Preset != "conventional" {
+		t.Errorf("Preset = %q, want conventional", config.Changelog.Preset)
+	}
+
+	wantSections := []ChangelogSection{
+		{Title: "Features", Types: []string{"feat"}},
+		{Title: "Bug Fixes", Types: []string{"fix", "perf"}},
+		{Title: "chore", Types: []string{"chore"}, Hidden: true},
+	}
+	if !reflect.DeepEqual(config.Changelog.Sections, wantSections) {
+		t.Errorf("Sections = %+v, want %+v", config.Changelog.Sections, wantSections)
+	}
+
+	wantRules := []ReleaseRule{{Type: "docs", Release: "patch"}}
+	if !reflect.DeepEqual(config.Versioning.ReleaseRules, wantRules) {
+		t.Errorf("ReleaseRules = %+v, want %+v", config.Versioning.ReleaseRules, wantRules)
+	}
+
+	if len(conv.Warnings) != 1 || conv.Warnings[0].Field != "releaseRules" {
+		t.Errorf("Warnings = %v, want one releaseRules warning", conv.Warnings)
+	}
+}
+
+func TestConvert_SemanticRelease_TopLevelPreset(t *testing.T) {
+	tests := []struct {
+		name       string
+		configData map[string]any
+		wantPreset string
+		wantSource string
+		wantRules  []ReleaseRule
+	}{
+		{
+			name: "top level only",
+			configData: map[string]any{
+				"preset":       "angular",
+				"releaseRules": []any{map[string]any{"type": "refactor", "release": "patch"}},
+				"plugins":      []any{"@semantic-release/commit-analyzer", "@semantic-release/release-notes-generator"},
+			},
+			wantPreset: "angular",
+			wantSource: "preset",
+			wantRules:  []ReleaseRule{{Type: "refactor", Release: "patch"}},
+		},
+		{
+			name: "plugin options win",
+			configData: map[string]any{
+				"preset":       "angular",
+				"releaseRules": []any{map[string]any{"type": "refactor", "release": "patch"}},
+				"plugins": []any{
+					[]any{"@semantic-release/commit-analyzer", map[string]any{
+						"preset":       "conventionalcommits",
+						"releaseRules": []any{map[string]any{"type": "docs", "release": "patch"}},
+					}},
+				},
+			},
+			wantPreset: "conventional",
+			wantSource: "plugins[@semantic-release/commit-analyzer].preset",
+			wantRules:  []ReleaseRule{{Type: "docs", Release: "patch"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv, err := ConvertDetailed(&detector.Result{
+				Tool:       detector.ToolSemanticRelease,
+				ConfigData: tt.configData,
+			})
+			if err != nil {
+				t.Fatalf("ConvertDetailed() error = %v", err)
+			}
+
+			if got := conv.Config.Changelog.Preset; got != tt.wantPreset {
+				t.Errorf("Preset = %q, want %q", got, tt.wantPreset)
+			}
+			if got := conv.Provenance["changelog.preset"]; got != "from "+tt.wantSource {
+				t.Errorf("Provenance = %q, want from %s", got, tt.wantSource)
+			}
+			if !reflect.DeepEqual(conv.Config.Versioning.ReleaseRules, tt.wantRules) {
+				t.Errorf("ReleaseRules = %+v, want %+v", conv.Config.Versioning.ReleaseRules, tt.wantRules)
+			}
+		})
+	}
+}
+
+func TestConvert_StandardVersion_CommitMessage(t *testing.T) {
+	tests := []struct {
+		format      string
+		want        string
+		wantWarning bool
+	}{
+		{"chore(release): {{currentTag}}", "chore(release): {{.Tag}}", false},
+		{"chore(release): {{version}} [skip ci]", "chore(release): {{.Version}} [skip ci]", false},
+		{"chore(release): {{previousTag}}...{{currentTag}}", "chore(release): {{previousTag}}...{{.Tag}}", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			conv, err := ConvertDetailed(&detector.Result{
+				Tool:       detector.ToolStandardVersion,
+				ConfigData: map[string]any{"releaseCommitMessageFormat": tt.format},
+			})
+			if err != nil {
+				t.Fatalf("ConvertDetailed() error = %v", err)
+			}
+			if got := conv.Config.Git.CommitMessage; got != tt.want {
+				t.Errorf("CommitMessage = %q, want %q", got, tt.want)
+			}
+			warned := false
+			for _, w := range conv.Warnings {
+				warned = warned || w.Field == "git.commit_message"
+			}
+			if warned != tt.wantWarning {
+				t.Errorf("warned = %v, want %v (warnings: %v)", warned, tt.wantWarning, conv.Warnings)
+			}
+		})
+	}
+}
+
+func TestConvert_StandardVersion_Changelog(t *testing.T) {
+	result := &detector.Result{
+		Tool:       detector.ToolStandardVersion,
+		ConfigFile: ".versionrc.json",
+		ConfigData: map[string]any{
+			"preset": "conventionalcommits",
+			"header": "# Release Notes\n",
+			"types": []any{
+				map[string]any{"type": "feat", "section": "Features"},
+				map[string]any{"type": "fix", "section": "Bug Fixes"},
+				map[string]any{"type": "perf", "section": "Bug Fixes"},
+				map[string]any{"type": "chore", "hidden": true},
+			},
+			"commitUrlFormat": "https://gitlab.example.com/{{owner}}/{{repository}}/-/commit/{{hash}}",
+			"issueUrlFormat":  "https://gitlab.example.com/{{owner}}/{{repository}}/-/issues/{{id}}",
+		},
+	}
+
+	config, err := Convert(result)
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+
+	if config.Changelog.Preset != "conventional" {
+		t.Errorf("Preset = %q, want conventional", config.Changelog.Preset)
+	}
+	if config.Changelog.Header != "# Release Notes\n" {
+		t.Errorf("Header = %q, want %q", config.Changelog.Header, "# Release Notes\n")
+	}
+	if config.Changelog.CommitURLFormat != "https://gitlab.example.com/{{owner}}/{{repository}}/-/commit/{{hash}}" {
+		t.Errorf("CommitURLFormat = %q", config.Changelog.CommitURLFormat)
+	}
+	if config.Changelog.IssueURLFormat != "https://gitlab.example.com/{{owner}}/{{repository}}/-/issues/{{id}}" {
+		t.Errorf("IssueURLFormat = %q", config.Changelog.IssueURLFormat)
+	}
+
+	sections := config.Changelog.Sections
+	if len(sections) != 3 {
+		t.Fatalf("Sections = %+v, want 3", sections)
+	}
+	if sections[1].Title != "Bug Fixes" || len(sections[1].Types) != 2 {
+		t.Errorf("Sections[1] = %+v, want Bug Fixes with fix and perf", sections[1])
+	}
+	if !sections[2].Hidden || sections[2].Title != "chore" {
+		t.Errorf("Sections[2] = %+v, want hidden chore", sections[2])
+	}
+}
+
+func TestMapChangelogPreset(t *testing.T) {
+	tests := []struct {
+		preset       string
+		want         string
+		wantWarnings int
+	}{
+		{"angular", "angular", 0},
+		{"conventionalcommits", "conventional", 0},
+		{"conventional-changelog-conventionalcommits", "conventional", 0},
+		{"eslint", "eslint", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.preset, func(t *testing.T) {
+			conv := newConversion(&RelictaConfig{})
+			if got := mapChangelogPreset(tt.preset, conv); got != tt.want {
+				t.Errorf("mapChangelogPreset(%q) = %q, want %q", tt.preset, got, tt.want)
+			}
+			if len(conv.Warnings) != tt.wantWarnings {
+				t.Errorf("warnings = %v, want %d", conv.Warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestConvertGoReleaserTemplate(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"{{ .Version }}-SNAPSHOT-{{ .ShortCommit }}", "{{.Version}}-SNAPSHOT-{{.ShortCommit}}"},
+		{"{{ incpatch .Version }}-next", "{{.NextPatch}}-next"},
+		{"{{ incminor .Version }}-dev+{{.Commit}}", "{{.NextMinor}}-dev+{{.Commit}}"},
+		{"{{- .FullCommit -}}", "{{.Commit}}"},
+		{"nightly", "nightly"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := convertGoReleaserTemplate(tt.input); got != tt.want {
+				t.Errorf("convertGoReleaserTemplate(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGoReleaserDist(t *testing.T) {
+	tests := []struct {
+		name string
+		dist any
+		want string
+	}{
+		{name: "default", dist: nil, want: "dist"},
+		{name: "empty", dist: "", want: "dist"},
+		{name: "custom", dist: "release", want: "release"},
+		{name: "trailing slash", dist: "build/out/", want: "build/out"},
+		{name: "windows separators", dist: `build\out`, want: "build/out"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := map[string]any{}
+			if tt.dist != nil {
+				data["dist"] = tt.dist
+			}
+			if got := goReleaserDist(data); got != tt.want {
+				t.Errorf("goReleaserDist() = %q, want %q", got, tt.want)
+			}
+
+			assets := extractGoReleaserAssets(data, "tool", true)
+			if last := assets[len(assets)-1]; last != tt.want+"/checksums.txt" {
+				t.Errorf("checksums asset = %q, want %q", last, tt.want+"/checksums.txt")
+			}
+		})
+	}
+}
+
+func TestExtractGoReleaserAssets_Source(t *testing.T) {
+	tests := []struct {
+		name   string
+		source map[string]any
+		want   string
+	}{
+		{name: "disabled", source: map[string]any{"enabled": false}, want: ""},
+		{name: "defaults", source: map[string]any{"enabled": true}, want: "dist/tool-{{.Version}}.tar.gz"},
+		{name: "custom", source: map[string]any{"enabled": true, "name_template": "{{ .ProjectName }}_source", "format": "zip"}, want: "dist/tool_source.zip"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := map[string]any{
+				"builds": []any{map[string]any{"goos": []any{"linux"}, "goarch": []any{"amd64"}}},
+				"source": tt.source,
+			}
+			assets := extractGoReleaserAssets(data, "tool", true)
+			want := []string{"dist/tool_linux_amd64.tar.gz", "dist/checksums.txt"}
+			if tt.want != "" {
+				want = []string{"dist/tool_linux_amd64.tar.gz", tt.want, "dist/checksums.txt"}
+			}
+			if !reflect.DeepEqual(assets, want) {
+				t.Errorf("extractGoReleaserAssets() = %v, want %v", assets, want)
+			}
+		})
+	}
+}
+
+func TestConvert_GoReleaser_MilestonesAndChangelogUse(t *testing.T) {
+	conv, err := ConvertDetailed(&detector.Result{
+		Tool: detector.ToolGoReleaser,
+		ConfigData: map[string]any{
+			"changelog": map[string]any{"use": "github"},
+			"release":   map[string]any{"github": map[string]any{"owner": "acme", "name": "demo"}},
+			"milestones": []any{
+				map[string]any{"close": true, "fail_on_error": true, "name_template": "{{ .Tag }}"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+
+	if got := conv.Config.Changelog.Source; got != "github" {
+		t.Errorf("Changelog.Source = %q, want github", got)
+	}
+	want := []any{map[string]any{"close": true, "fail_on_error": true, "name_template": "{{.Tag}}"}}
+	if got := conv.Config.Plugins[0].Config["milestones"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("milestones = %#v, want %#v", got, want)
+	}
+
+	// An unknown changelog source is reported rather than guessed
+	conv, err = ConvertDetailed(&detector.Result{
+		Tool:       detector.ToolGoReleaser,
+		ConfigData: map[string]any{"changelog": map[string]any{"use": "bitbucket"}},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+	if conv.Config.Changelog.Source != "" || len(conv.Warnings) != 1 {
+		t.Errorf("Source = %q, Warnings = %v, want no source and one warning", conv.Config.Changelog.Source, conv.Warnings)
+	}
+}
+
+func TestConvert_GoReleaser_ArchiveFiles(t *testing.T) {
+	conv, err := ConvertDetailed(&detector.Result{
+		Tool: detector.ToolGoReleaser,
+		ConfigData: map[string]any{
+			"archives": []any{
+				map[string]any{"files": []any{
+					"LICENSE",
+					"completions/*",
+					map[string]any{"src": "man/*.1", "dst": "share/man/man1", "strip_parent": true},
+				}},
+				map[string]any{"id": "windows", "files": []any{"LICENSE", map[string]any{"src": "README.md"}}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+
+	want := []any{
+		"LICENSE",
+		"completions/*",
+		map[string]any{"src": "man/*.1", "dst": "share/man/man1", "strip_parent": true},
+		"README.md",
+	}
+	if got := conv.Config.Plugins[0].Config["archive_files"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("archive_files = %v, want %v", got, want)
+	}
+}
+
+func TestExtractGoReleaserAssets_UniversalBinaries(t *testing.T) {
+	tests := []struct {
+		name      string
+		universal any
+		archives  []any
+		want      []string
+	}{
+		{
+			name:      "replace",
+			universal: []any{map[string]any{"replace": true}},
+			want: []string{
+				"dist/tool_linux_amd64.tar.gz",
+				"dist/tool_linux_arm64.tar.gz",
+				"dist/tool_darwin_all.tar.gz",
+				"dist/checksums.txt",
+			},
+		},
+		{
+			name:      "keep per-arch binaries",
+			universal: []any{map[string]any{"id": "tool"}},
+			want: []string{
+				"dist/tool_linux_amd64.tar.gz",
+				"dist/tool_linux_arm64.tar.gz",
+				"dist/tool_darwin_all.tar.gz",
+				"dist/tool_darwin_amd64.tar.gz",
+				"dist/tool_darwin_arm64.tar.gz",
+				"dist/checksums.txt",
+			},
+		},
+		{
+			name:      "configured archive format",
+			universal: []any{map[string]any{"replace": true}},
+			archives:  []any{map[string]any{"format": "zip"}},
+			want: []string{
+				"dist/tool_linux_amd64.zip",
+				"dist/tool_linux_arm64.zip",
+				"dist/tool_darwin_all.zip",
+				"dist/checksums.txt",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := map[string]any{
+				"builds": []any{
+					map[string]any{
+						"goos":   []any{"linux", "darwin"},
+						"goarch": []any{"amd64", "arm64"},
+					},
+				},
+				"universal_binaries": tt.universal,
+			}
+			if tt.archives != nil {
+				data["archives"] = tt.archives
+			}
+
+			got := extractGoReleaserAssets(data, "tool", true)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("assets = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert_GoReleaser_Kos(t *testing.T) {
+	conv, err := ConvertDetailed(&detector.Result{
+		Tool: detector.ToolGoReleaser,
+		ConfigData: map[string]any{
+			"kos": []any{
+				map[string]any{
+					"repository": "ghcr.io/acme/demo",
+					"tags":       []any{"{{ .Tag }}", "latest"},
+					"platforms":  []any{"linux/amd64", "linux/arm64"},
+					"base_image": "cgr.dev/chainguard/static",
+					"bare":       true,
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+
+	var ko *PluginConfig
+	for i := range conv.Config.Plugins {
+		if conv.Config.Plugins[i].Name == "ko" {
+			ko = &conv.Config.Plugins[i]
+		}
+	}
+	if ko == nil {
+		t.Fatalf("Plugins = %+v, want a ko plugin", conv.Config.Plugins)
+	}
+	want := map[string]any{
+		"repository": "ghcr.io/acme/demo",
+		"tags":       []any{"{{.Tag}}", "latest"},
+		"platforms":  []any{"linux/amd64", "linux/arm64"},
+		"base_image": "cgr.dev/chainguard/static",
+	}
+	if !reflect.DeepEqual(ko.Config, want) {
+		t.Errorf("Config = %#v, want %#v", ko.Config, want)
+	}
+	if !ko.Enabled {
+		t.Error("ko plugin should be enabled")
+	}
+}
+
+func TestConvert_GoReleaser_Build(t *testing.T) {
+	conv, err := ConvertDetailed(&detector.Result{
+		Tool: detector.ToolGoReleaser,
+		ConfigData: map[string]any{
+			"project_name": "demo",
+			"gomod":        map[string]any{"proxy": true},
+			"builds": []any{
+				map[string]any{
+					"binary":  "demo",
+					"goos":    []any{"linux"},
+					"env":     []any{"CGO_ENABLED=0"},
+					"ldflags": []any{"-s -w -X main.version={{ .Version }} -X main.commit={{.Commit}}"},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+
+	var build *PluginConfig
+	for i := range conv.Config.Plugins {
+		if conv.Config.Plugins[i].Name == "goreleaser-build" {
+			build = &conv.Config.Plugins[i]
+		}
+	}
+	if build == nil {
+		t.Fatalf("Plugins = %+v, want a goreleaser-build plugin", conv.Config.Plugins)
+	}
+	if build.Enabled {
+		t.Error("goreleaser-build plugin should be disabled")
+	}
+	if build.Config["_note"] == nil {
+		t.Error("goreleaser-build plugin should carry a _note")
+	}
+
+	want := map[string]any{
+		"binary":  "demo",
+		"env":     []any{"CGO_ENABLED=0"},
+		"ldflags": []any{"-s -w -X main.version={{.Version}} -X main.commit={{.Commit}}"},
+		"gomod":   map[string]any{"proxy": true},
+	}
+	for key, value := range want {
+		if !reflect.DeepEqual(build.Config[key], value) {
+			t.Errorf("Config[%q] = %#v, want %#v", key, build.Config[key], value)
+		}
+	}
+
+	// Reproducibility settings are kept even without builds
+	conv, err = ConvertDetailed(&detector.Result{
+		Tool: detector.ToolGoReleaser,
+		ConfigData: map[string]any{
+			"metadata":     map[string]any{"mod_timestamp": "{{ .CommitTimestamp }}"},
+			"report_sizes": true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+	build = nil
+	for i := range conv.Config.Plugins {
+		if conv.Config.Plugins[i].Name == "goreleaser-build" {
+			build = &conv.Config.Plugins[i]
+		}
+	}
+	if build == nil {
+		t.Fatalf("Plugins = %+v, want a goreleaser-build plugin", conv.Config.Plugins)
+	}
+	if got, want := build.Config["metadata"], map[string]any{"mod_timestamp": "{{.CommitTimestamp}}"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Config[metadata] = %#v, want %#v", got, want)
+	}
+	if build.Config["report_sizes"] != true {
+		t.Errorf("Config[report_sizes] = %v, want true", build.Config["report_sizes"])
+	}
+	if keys := conv.UnmappedKeys(map[string]any{"metadata": nil, "report_sizes": true}); len(keys) > 0 {
+		t.Errorf("UnmappedKeys() = %v, want metadata and report_sizes mapped", keys)
+	}
+
+	// gomod alone is mapped from gomod, and builds is not claimed
+	gomodOnly := map[string]any{
+		"gomod":        map[string]any{"proxy": true},
+		"report_sizes": true,
+	}
+	conv, err = ConvertDetailed(&detector.Result{Tool: detector.ToolGoReleaser, ConfigData: gomodOnly})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+	if keys := conv.UnmappedKeys(gomodOnly); len(keys) > 0 {
+		t.Errorf("UnmappedKeys() = %v, want gomod and report_sizes mapped", keys)
+	}
+	for _, m := range conv.Mappings() {
+		if m.SourceKey() == "builds" {
+			t.Errorf("Mappings() has %v, want none from builds", m)
+		}
+	}
+
+	// Builds without flags leave nothing to keep
+	conv, err = ConvertDetailed(&detector.Result{
+		Tool: detector.ToolGoReleaser,
+		ConfigData: map[string]any{
+			"builds": []any{map[string]any{"goos": []any{"linux"}}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+	for _, plugin := range conv.Config.Plugins {
+		if plugin.Name == "goreleaser-build" {
+			t.Errorf("Plugins = %+v, want no goreleaser-build plugin", conv.Config.Plugins)
+		}
+	}
+}
+
+func TestConvert_GoReleaser_SBOMs(t *testing.T) {
+	conv, err := ConvertDetailed(&detector.Result{
+		Tool: detector.ToolGoReleaser,
+		ConfigData: map[string]any{
+			"project_name": "demo",
+			"sboms": []any{
+				map[string]any{
+					"artifacts": "archive",
+					"documents": []any{"${artifact}.spdx.sbom.json"},
+					"cmd":       "syft",
+					"args":      []any{"$artifact", "--output", "spdx-json=${document0}"},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+
+	var sbom *PluginConfig
+	for i := range conv.Config.Plugins {
+		if conv.Config.Plugins[i].Name == "sbom" {
+			sbom = &conv.Config.Plugins[i]
+		}
+	}
+	if sbom == nil {
+		t.Fatalf("Plugins = %+v, want an sbom plugin", conv.Config.Plugins)
+	}
+	if sbom.Enabled {
+		t.Error("sbom plugin should be disabled")
+	}
+	if sbom.Config["_note"] == nil {
+		t.Error("sbom plugin should carry a _note")
+	}
+
+	want := map[string]any{
+		"artifacts": "archive",
+		"documents": []any{"{{.Artifact}}.spdx.sbom.json"},
+		"cmd":       "syft",
+		"args":      []any{"{{.Artifact}}", "--output", "spdx-json={{index .Documents 0}}"},
+	}
+	for key, value := range want {
+		if !reflect.DeepEqual(sbom.Config[key], value) {
+			t.Errorf("Config[%q] = %#v, want %#v", key, sbom.Config[key], value)
+		}
+	}
+
+	if len(conv.Warnings) != 1 || conv.Warnings[0].Field != "plugins" {
+		t.Errorf("Warnings = %v, want one about the sbom plugin", conv.Warnings)
+	}
+}
+
+func TestConvert_GoReleaser_Checksum(t *testing.T) {
+	tests := []struct {
+		name          string
+		checksum      map[string]any
+		wantAsset     string
+		wantAlgorithm string
+	}{
+		{
+			name:      "default",
+			wantAsset: "dist/checksums.txt",
+		},
+		{
+			name: "name template and algorithm",
+			checksum: map[string]any{
+				"name_template": "{{ .ProjectName }}_SHA256SUMS",
+				"algorithm":     "sha256",
+			},
+			wantAsset:     "dist/myapp_SHA256SUMS",
+			wantAlgorithm: "sha256",
+		},
+		{
+			name:     "disabled",
+			checksum: map[string]any{"disable": true, "algorithm": "sha512"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := map[string]any{"project_name": "myapp"}
+			if tt.checksum != nil {
+				data["checksum"] = tt.checksum
+			}
+			config, err := Convert(&detector.Result{Tool: detector.ToolGoReleaser, ConfigData: data})
+			if err != nil {
+				t.Fatalf("Convert() error = %v", err)
+			}
+
+			assets, _ := config.Plugins[0].Config["assets"].([]string)
+			last := assets[len(assets)-1]
+			if tt.wantAsset == "" {
+				if strings.Contains(last, "checksum") || strings.Contains(last, "SUMS") {
+					t.Errorf("checksum asset %q should not be emitted", last)
+				}
+			} else if last != tt.wantAsset {
+				t.Errorf("checksum asset = %q, want %q", last, tt.wantAsset)
+			}
+
+			var algorithm any
+			for _, p := range config.Plugins {
+				if p.Name == "checksum" {
+					algorithm = p.Config["algorithm"]
+				}
+			}
+			if tt.wantAlgorithm == "" && algorithm != nil {
+				t.Errorf("unexpected checksum plugin with algorithm %v", algorithm)
+			}
+			if tt.wantAlgorithm != "" && algorithm != tt.wantAlgorithm {
+				t.Errorf("algorithm = %v, want %q", algorithm, tt.wantAlgorithm)
+			}
+		})
+	}
+}
+
+func TestConvert_GoReleaser_Snapshot(t *testing.T) {
+	tests := []struct {
+		name     string
+		snapshot map[string]any
+		want     string
+	}{
+		{
+			name:     "v2 version_template",
+			snapshot: map[string]any{"version_template": "{{ incpatch .Version }}-next"},
+			want:     "{{.NextPatch}}-next",
+		},
+		{
+			name:     "v1 name_template",
+			snapshot: map[string]any{"name_template": "{{ .Version }}-SNAPSHOT-{{ .Commit }}"},
+			want:     "{{.Version}}-SNAPSHOT-{{.Commit}}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := &detector.Result{
+				Tool:       detector.ToolGoReleaser,
+				ConfigData: map[string]any{"snapshot": tt.snapshot},
+			}
+
+			config, err := Convert(result)
+			if err != nil {
+				t.Fatalf("Convert() error = %v", err)
+			}
+			if config.Versioning.SnapshotTemplate != tt.want {
+				t.Errorf("SnapshotTemplate = %q, want %q", config.Versioning.SnapshotTemplate, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert_GoReleaser_ReleaseMode(t *testing.T) {
+	conv, err := ConvertDetailed(&detector.Result{
+		Tool: detector.ToolGoReleaser,
+		ConfigData: map[string]any{
+			"release": map[string]any{"mode": "keep-existing"},
+			"git":     map[string]any{"tag_sort": "-version:creatordate"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+	if got := conv.Config.Plugins[0].Config["mode"]; got != "keep_existing" {
+		t.Errorf("mode = %v, want keep_existing", got)
+	}
+	if !strings.Contains(conv.Config.Versioning.Note, "-version:creatordate") {
+		t.Errorf("Versioning.Note = %q, want the tag_sort value", conv.Config.Versioning.Note)
+	}
+
+	conv, err = ConvertDetailed(&detector.Result{
+		Tool:       detector.ToolGoReleaser,
+		ConfigData: map[string]any{"release": map[string]any{"mode": "merge"}},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+	if _, ok := conv.Config.Plugins[0].Config["mode"]; ok || len(conv.Warnings) != 1 {
+		t.Errorf("unknown mode: config = %v, warnings = %v, want one warning and no mode", conv.Config.Plugins[0].Config, conv.Warnings)
+	}
+}
+
+func TestConvert_Project(t *testing.T) {
+	tests := []struct {
+		name   string
+		result *detector.Result
+		want   *ProjectConfig
+	}{
+		{
+			name:   "goreleaser project_name",
+			result: &detector.Result{Tool: detector.ToolGoReleaser, ConfigData: map[string]any{"project_name": "tool"}},
+			want:   &ProjectConfig{Name: "tool"},
+		},
+		{
+			name:   "goreleaser without project_name",
+			result: &detector.Result{Tool: detector.ToolGoReleaser, ConfigData: map[string]any{}},
+		},
+		{
+			name: "package.json name",
+			result: &detector.Result{
+				Tool:       detector.ToolReleaseIt,
+				ConfigData: map[string]any{},
+				Details:    map[string]any{"packageName": "@scope/app"},
+			},
+			want: &ProjectConfig{Name: "@scope/app"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv, err := ConvertDetailed(tt.result)
+			if err != nil {
+				t.Fatalf("ConvertDetailed() error = %v", err)
+			}
+			if !reflect.DeepEqual(conv.Config.Project, tt.want) {
+				t.Errorf("Project = %+v, want %+v", conv.Config.Project, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert_GoReleaser_ReleaseDisable(t *testing.T) {
+	tests := []struct {
+		name           string
+		release        map[string]any
+		wantEnabled    bool
+		wantSkipUpload any
+		wantWarnings   int
+	}{
+		{"defaults", map[string]any{}, true, nil, 0},
+		{"disable", map[string]any{"disable": true}, false, nil, 0},
+		{"skip_upload", map[string]any{"skip_upload": true}, true, true, 0},
+		{"templates", map[string]any{"disable": "{{ .Env.NO_RELEASE }}", "skip_upload": "{{ .Env.NO_UPLOAD }}"}, true, nil, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv, err := ConvertDetailed(&detector.Result{
+				Tool:       detector.ToolGoReleaser,
+				ConfigData: map[string]any{"release": tt.release},
+			})
+			if err != nil {
+				t.Fatalf("ConvertDetailed() error = %v", err)
+			}
+			plugin := conv.Config.Plugins[0]
+			if plugin.Enabled != tt.wantEnabled {
+				t.Errorf("Enabled = %v, want %v", plugin.Enabled, tt.wantEnabled)
+			}
+			if got := plugin.Config["skip_upload"]; got != tt.wantSkipUpload {
+				t.Errorf("skip_upload = %v, want %v", got, tt.wantSkipUpload)
+			}
+			if len(conv.Warnings) != tt.wantWarnings {
+				t.Errorf("Warnings = %v, want %d", conv.Warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestConvert_GoReleaser_ArchNames(t *testing.T) {
+	const common = `{{ .ProjectName }}_{{ .Os }}_{{- if eq .Arch "amd64" }}x86_64{{- else if eq .Arch "386" }}i386{{- else }}{{ .Arch }}{{ end }}`
+	tests := []struct {
+		name       string
+		template   string
+		keepGoarch bool
+		want       []string
+	}{
+		{"no name_template", "", false, []string{"tool_linux_amd64.tar.gz", "tool_linux_arm64.tar.gz", "tool_linux_386.tar.gz"}},
+		{"GOARCH names", "{{ .ProjectName }}_{{ .Os }}_{{ .Arch }}", false, []string{"tool_linux_amd64.tar.gz", "tool_linux_arm64.tar.gz", "tool_linux_386.tar.gz"}},
+		{"x86_64 and i386 only", common, false, []string{"tool_linux_x86_64.tar.gz", "tool_linux_arm64.tar.gz", "tool_linux_i386.tar.gz"}},
+		{"keepGoarch", common, true, []string{"tool_linux_amd64.tar.gz", "tool_linux_arm64.tar.gz", "tool_linux_386.tar.gz"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := map[string]any{
+				"project_name": "tool",
+				"builds":       []any{map[string]any{"goos": []any{"linux"}, "goarch": []any{"amd64", "arm64", "386"}}},
+			}
+			if tt.template != "" {
+				data["archives"] = []any{map[string]any{"name_template": tt.template}}
+			}
+			conv, err := ConvertDetailed(&detector.Result{
+				Tool:       detector.ToolGoReleaser,
+				ConfigData: data,
+				Details:    map[string]any{"keepGoarch": tt.keepGoarch},
+			})
+			if err != nil {
+				t.Fatalf("ConvertDetailed() error = %v", err)
+			}
+			assets, _ := conv.Config.Plugins[0].Config["assets"].([]string)
+			for _, want := range tt.want {
+				found := false
+				for _, asset := range assets {
+					found = found || asset == "dist/"+want
+				}
+				if !found {
+					t.Errorf("assets = %v, want %s", assets, want)
+				}
+			}
+		})
+	}
+}
+
+func TestConvert_GoReleaser_Forge(t *testing.T) {
+	tests := []struct {
+		name       string
+		configData map[string]any
+		wantPlugin string
+		wantOwner  string
+		wantRepo   string
+		wantHost   string
+	}{
+		{
+			name: "github",
+			configData: map[string]any{
+				"release": map[string]any{
+					"github": map[string]any{"owner": "acme", "name": "tool"},
+				},
+			},
+			wantPlugin: "github",
+			wantOwner:  "acme",
+			wantRepo:   "tool",
+		},
+		{
+			name: "self-hosted gitlab",
+			configData: map[string]any{
+				"release": map[string]any{
+					"gitlab": map[string]any{"owner": "group", "name": "tool"},
+				},
+				"gitlab_urls": map[string]any{
+					"api":      "https://gitlab.example.com/api/v4/",
+					"download": "https://gitlab.example.com",
+				},
+			},
+			wantPlugin: "gitlab",
+			wantOwner:  "group",
+			wantRepo:   "tool",
+			wantHost:   "https://gitlab.example.com",
+		},
+		{
+			name: "gitea host from api url",
+			configData: map[string]any{
+				"release": map[string]any{
+					"gitea": map[string]any{"owner": "org", "name": "tool"},
+				},
+				"gitea_urls": map[string]any{"api": "https://gitea.example.com/api/v1"},
+			},
+			wantPlugin: "gitea",
+			wantOwner:  "org",
+			wantRepo:   "tool",
+			wantHost:   "https://gitea.example.com",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := &detector.Result{
+				Tool:       detector.ToolGoReleaser,
+				ConfigData: tt.configData,
+			}
+
+			config, err := Convert(result)
+			if err != nil {
+				t.Fatalf("Convert() error = %v", err)
+			}
+			if len(config.Plugins) != 1 {
+				t.Fatalf("Plugins = %v, want one plugin", config.Plugins)
+			}
+
+			plugin := config.Plugins[0]
+			if plugin.Name != tt.wantPlugin {
+				t.Errorf("plugin name = %q, want %q", plugin.Name, tt.wantPlugin)
+			}
+			if plugin.Config["owner"] != tt.wantOwner {
+				t.Errorf("owner = %v, want %q", plugin.Config["owner"], tt.wantOwner)
+			}
+			if plugin.Config["repo"] != tt.wantRepo {
+				t.Errorf("repo = %v, want %q", plugin.Config["repo"], tt.wantRepo)
+			}
+			if host, _ := plugin.Config["host"].(string); host != tt.wantHost {
+				t.Errorf("host = %q, want %q", host, tt.wantHost)
+			}
+			if _, ok := plugin.Config["assets"]; !ok {
+				t.Error("assets should be attached to the release plugin")
+			}
+		})
+	}
+}
+
+func TestConvert_ReleaseIt_GitHubAssets(t *testing.T) {
+	tests := []struct {
+		name   string
+		assets any
+		want   []string
+	}{
+		{name: "list", assets: []any{"dist/*.zip", "dist/*.tar.gz"}, want: []string{"dist/*.zip", "dist/*.tar.gz"}},
+		{name: "single glob", assets: "dist/*.zip", want: []string{"dist/*.zip"}},
+		{name: "none", assets: nil, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			github := map[string]any{"release": true}
+			if tt.assets != nil {
+				github["assets"] = tt.assets
+			}
+			config, err := Convert(&detector.Result{
+				Tool:       detector.ToolReleaseIt,
+				ConfigData: map[string]any{"github": github},
+			})
+			if err != nil {
+				t.Fatalf("Convert() error = %v", err)
+			}
+
+			got, _ := config.Plugins[0].Config["assets"].([]string)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("assets = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert_ReleaseIt_GitChangelog(t *testing.T) {
+	tests := []struct {
+		name         string
+		git          map[string]any
+		wantEnabled  bool
+		wantWarnings int
+		wantNote     string
+	}{
+		{
+			name:         "custom changelog command",
+			git:          map[string]any{"changelog": "npx auto-changelog --stdout"},
+			wantEnabled:  true,
+			wantWarnings: 1,
+		},
+		{
+			name:        "changelog disabled",
+			git:         map[string]any{"changelog": false},
+			wantEnabled: false,
+		},
+		{
+			name:        "tagExclude",
+			git:         map[string]any{"tagExclude": "*-beta*"},
+			wantEnabled: true,
+			wantNote:    `"*-beta*"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv, err := ConvertDetailed(&detector.Result{
+				Tool:       detector.ToolReleaseIt,
+				ConfigData: map[string]any{"git": tt.git},
+			})
+			if err != nil {
+				t.Fatalf("ConvertDetailed() error = %v", err)
+			}
+			if conv.Config.Changelog.Enabled != tt.wantEnabled {
+				t.Errorf("Changelog.Enabled = %v, want %v", conv.Config.Changelog.Enabled, tt.wantEnabled)
+			}
+			if len(conv.Warnings) != tt.wantWarnings {
+				t.Errorf("Warnings = %v, want %d", conv.Warnings, tt.wantWarnings)
+			}
+			if !strings.Contains(conv.Config.Versioning.Note, tt.wantNote) {
+				t.Errorf("Versioning.Note = %q, want it to mention %s", conv.Config.Versioning.Note, tt.wantNote)
+			}
+		})
+	}
+}
+
+func TestConvert_ReleaseIt_MultiLineCommitMessage(t *testing.T) {
+	conv, err := ConvertDetailed(&detector.Result{
+		Tool: detector.ToolReleaseIt,
+		ConfigData: map[string]any{"git": map[string]any{
+			"commitMessage": "chore: release v${version} \r\n\r\n${changelog}\r\n",
+		}},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+	want := "chore: release v{{.Version}}\n\n{{.Changelog}}\n"
+	if got := conv.Config.Git.CommitMessage; got != want {
+		t.Errorf("CommitMessage = %q, want %q", got, want)
+	}
+	for _, w := range conv.Warnings {
+		if w.Field == "git.commit_message" {
+			t.Errorf("unexpected warning %v", w)
+		}
+	}
+}
+
+func TestConvert_ReleaseIt_Bumper(t *testing.T) {
+	conv, err := ConvertDetailed(&detector.Result{
+		Tool: detector.ToolReleaseIt,
+		ConfigData: map[string]any{"plugins": map[string]any{
+			"@release-it/bumper": map[string]any{
+				"in": map[string]any{"file": "version.json", "type": "application/json", "path": "version"},
+				"out": []any{
+					"VERSION",
+					map[string]any{"file": "chart/Chart.yaml", "type": "text/yaml", "path": "appVersion"},
+				},
+			},
+			"@release-it/keep-a-changelog": map[string]any{},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+
+	wantIn := &VersionFile{File: "version.json", Type: "json", Path: "version"}
+	if got := conv.Config.Versioning.VersionSourceFile; !reflect.DeepEqual(got, wantIn) {
+		t.Errorf("VersionSourceFile = %+v, want %+v", got, wantIn)
+	}
+	wantOut := []VersionFile{{File: "VERSION"}, {File: "chart/Chart.yaml", Type: "yaml", Path: "appVersion"}}
+	if got := conv.Config.Versioning.BumpFiles; !reflect.DeepEqual(got, wantOut) {
+		t.Errorf("BumpFiles = %+v, want %+v", got, wantOut)
+	}
+	if len(conv.Warnings) != 1 || !strings.Contains(conv.Warnings[0].Message, "keep-a-changelog") {
+		t.Errorf("Warnings = %v, want one for @release-it/keep-a-changelog", conv.Warnings)
+	}
+}
+
+func TestConvert_ReleaseIt_NpmPublishPath(t *testing.T) {
+	conv, err := ConvertDetailed(&detector.Result{
+		Tool: detector.ToolReleaseIt,
+		ConfigData: map[string]any{
+			"npm": map[string]any{
+				"publish":     true,
+				"publishPath": "dist",
+				"versionArgs": []any{"--allow-same-version", "--workspaces-update=false"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+
+	var npm *PluginConfig
+	for i := range conv.Config.Plugins {
+		if conv.Config.Plugins[i].Name == "npm" {
+			npm = &conv.Config.Plugins[i]
+		}
+	}
+	if npm == nil {
+		t.Fatalf("Plugins = %+v, want an npm plugin", conv.Config.Plugins)
+	}
+	if got := npm.Config["package_root"]; got != "dist" {
+		t.Errorf("package_root = %v, want dist", got)
+	}
+	note, _ := npm.Config["_note"].(string)
+	if !strings.Contains(note, "--allow-same-version --workspaces-update=false") {
+		t.Errorf("_note = %q, want the versionArgs", note)
+	}
+	if got := conv.Provenance["plugins.npm.config.package_root"]; got != "from npm.publishPath" {
+		t.Errorf("Provenance[package_root] = %q, want from npm.publishPath", got)
+	}
+}
+
+func TestConvert_ReleaseIt_LernaChangelog(t *testing.T) {
+	conv, err := ConvertDetailed(&detector.Result{
+		Tool: detector.ToolReleaseIt,
+		ConfigData: map[string]any{"plugins": map[string]any{
+			"@release-it-plugins/lerna-changelog": map[string]any{
+				"infile":       "CHANGES.md",
+				"launchEditor": true,
+			},
+		}},
+		Details: map[string]any{"changelogLabels": []any{
+			map[string]any{"label": "feature", "section": "Features"},
+			map[string]any{"label": "bug", "section": "Fixes"},
+			map[string]any{"label": "enhancement", "section": "Features"},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+
+	changelog := conv.Config.Changelog
+	if !changelog.Enabled || changelog.File != "CHANGES.md" || changelog.Editor != "{{.Env.EDITOR}}" {
+		t.Errorf("Changelog = %+v, want enabled, CHANGES.md and {{.Env.EDITOR}}", changelog)
+	}
+	want := []ChangelogSection{
+		{Title: "Features", Labels: []string{"feature", "enhancement"}},
+		{Title: "Fixes", Labels: []string{"bug"}},
+	}
+	if !reflect.DeepEqual(changelog.Sections, want) {
+		t.Errorf("Sections = %+v, want %+v", changelog.Sections, want)
+	}
+	if got := conv.Provenance["changelog.sections"]; got != "from plugins[@release-it-plugins/lerna-changelog] labels" {
+		t.Errorf("Provenance[changelog.sections] = %q", got)
+	}
+	for _, w := range conv.Warnings {
+		if strings.Contains(w.Message, "lerna-changelog") {
+			t.Errorf("unexpected warning %v", w)
+		}
+	}
+
+	// Without configured labels, lerna-changelog's defaults apply.
+	conv, err = ConvertDetailed(&detector.Result{
+		Tool: detector.ToolReleaseIt,
+		ConfigData: map[string]any{"plugins": map[string]any{
+			"release-it-lerna-changelog": map[string]any{"infile": false},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+	if got := conv.Config.Changelog; got.File != "" || len(got.Sections) != 5 || got.Sections[0].Labels[0] != "breaking" {
+		t.Errorf("Changelog = %+v, want no file and the default sections", got)
+	}
+}
+
+func TestConvert_ReleaseIt_Hooks(t *testing.T) {
+	conv, err := ConvertDetailed(&detector.Result{
+		Tool: detector.ToolReleaseIt,
+		ConfigData: map[string]any{"hooks": map[string]any{
+			"after:release":     "echo Released ${version}",
+			"after:bump":        []any{"npm run build", "npm run docs", "git add dist docs"},
+			"before:init":       []any{"npm test"},
+			"after:git:release": "echo tagged",
+			"before:something":  "true",
+		}},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+
+	want := []HookConfig{
+		{Phase: "verify_conditions", Command: "npm test"},
+		{Phase: "prepare", Command: "npm run build"},
+		{Phase: "prepare", Command: "npm run docs"},
+		{Phase: "prepare", Command: "git add dist docs"},
+		{Phase: "success", Command: "echo tagged"},
+		{Phase: "success", Command: "echo Released {{.Version}}"},
+	}
+	if !reflect.DeepEqual(conv.Config.Hooks, want) {
+		t.Errorf("Hooks = %+v, want %+v", conv.Config.Hooks, want)
+	}
+	if len(conv.Warnings) != 2 {
+		t.Errorf("Warnings = %v, want one for after:git:release and one for before:something", conv.Warnings)
+	}
+}
+
+func TestConvert_ReleaseIt_Increment(t *testing.T) {
+	tests := []struct {
+		name           string
+		configData     map[string]any
+		wantStrategy   string
+		wantBump       string
+		wantPreset     string
+		wantPrerelease string
+	}{
+		{
+			name:         "conventional with preset",
+			configData:   map[string]any{"increment": "conventional:angular"},
+			wantStrategy: "conventional",
+			wantPreset:   "angular",
+		},
+		{
+			name:         "fixed increment",
+			configData:   map[string]any{"increment": "minor"},
+			wantStrategy: "manual",
+			wantBump:     "minor",
+		},
+		{
+			name:         "no bump",
+			configData:   map[string]any{"increment": false},
+			wantStrategy: "manual",
+			wantBump:     "none",
+		},
+		{
+			name:           "prerelease id",
+			configData:     map[string]any{"preReleaseId": "beta"},
+			wantStrategy:   "conventional",
+			wantPrerelease: "beta",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := &detector.Result{
+				Tool:       detector.ToolReleaseIt,
+				ConfigFile: ".release-it.json",
+				ConfigData: tt.configData,
+			}
+
+			config, err := Convert(result)
+			if err != nil {
+				t.Fatalf("Convert() error = %v", err)
+			}
+
+			if config.Versioning.Strategy != tt.wantStrategy {
+				t.Errorf("Strategy = %q, want %q", config.Versioning.Strategy, tt.wantStrategy)
+			}
+			if config.Versioning.Bump != tt.wantBump {
+				t.Errorf("Bump = %q, want %q", config.Versioning.Bump, tt.wantBump)
+			}
+			if config.Changelog.Preset != tt.wantPreset {
+				t.Errorf("Preset = %q, want %q", config.Changelog.Preset, tt.wantPreset)
+			}
+			if config.Versioning.Prerelease != tt.wantPrerelease {
+				t.Errorf("Prerelease = %q, want %q", config.Versioning.Prerelease, tt.wantPrerelease)
+			}
+		})
+	}
+}
+
+func TestMapSemanticReleasePlugin_NPM(t *testing.T) {
+	plugin := mapSemanticReleasePlugin("@semantic-release/npm", map[string]any{
+		"pkgRoot":    "dist",
+		"tarballDir": "release",
+		"npmPublish": false,
+		"registry":   "https://npm.example.com",
+	})
+
+	if plugin == nil || plugin.Name != "npm" {
+		t.Fatalf("mapSemanticReleasePlugin() = %+v, want npm plugin", plugin)
+	}
+
+	want := map[string]any{
+		"package_root": "dist",
+		"tarball_dir":  "release",
+		"publish":      false,
+		"registry":     "https://npm.example.com",
+	}
+	if len(plugin.Config) != len(want) {
+		t.Fatalf("Config = %v, want %v", plugin.Config, want)
+	}
+	for k, v := range want {
+		if plugin.Config[k] != v {
+			t.Errorf("Config[%q] = %v, want %v", k, plugin.Config[k], v)
+		}
+	}
+}
+
+func TestConvert_MavenRelease(t *testing.T) {
+	tests := []struct {
+		name       string
+		configData map[string]any
+		wantPrefix string
+		wantPlugin string
+		wantOwner  string
+		wantWarn   bool
+	}{
+		{
+			name: "v prefix with github scm",
+			configData: map[string]any{
+				"artifactId":    "demo",
+				"tagNameFormat": "v@{project.version}",
+				"scm": map[string]any{
+					"developerConnection": "scm:git:git@github.com:acme/demo.git",
+				},
+			},
+			wantPrefix: "v",
+			wantPlugin: "github",
+			wantOwner:  "acme",
+		},
+		{
+			name:       "default tag format",
+			configData: map[string]any{"artifactId": "demo"},
+			wantPrefix: "demo-",
+		},
+		{
+			name: "version not at the end",
+			configData: map[string]any{
+				"artifactId":    "demo",
+				"tagNameFormat": "@{project.version}-final",
+			},
+			wantPrefix: "@{project.version}-final",
+			wantWarn:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv, err := ConvertDetailed(&detector.Result{
+				Tool:       detector.ToolMavenRelease,
+				ConfigData: tt.configData,
+			})
+			if err != nil {
+				t.Fatalf("ConvertDetailed() error = %v", err)
+			}
+			config := conv.Config
+
+			if config.Versioning.TagPrefix != tt.wantPrefix {
+				t.Errorf("TagPrefix = %q, want %q", config.Versioning.TagPrefix, tt.wantPrefix)
+			}
+			if tt.wantPlugin != "" {
+				if len(config.Plugins) != 1 || config.Plugins[0].Name != tt.wantPlugin {
+					t.Fatalf("Plugins = %v, want %s", config.Plugins, tt.wantPlugin)
+				}
+				if config.Plugins[0].Config["owner"] != tt.wantOwner {
+					t.Errorf("owner = %v, want %q", config.Plugins[0].Config["owner"], tt.wantOwner)
+				}
+			}
+			if tt.wantWarn != (len(conv.Warnings) > 0) {
+				t.Errorf("Warnings = %v, want warning: %v", conv.Warnings, tt.wantWarn)
+			}
+		})
+	}
+}
+
+func TestConvert_Changesets(t *testing.T) {
+	tests := []struct {
+		name           string
+		pre            map[string]any
+		wantPrerelease string
+		wantNote       string
+	}{
+		{
+			name: "stable",
+		},
+		{
+			name: "prerelease mode",
+			pre: map[string]any{
+				"mode":            "pre",
+				"tag":             "next",
+				"initialVersions": map[string]any{"web": "2.0.0", "app": "1.4.0"},
+				"changesets":      []any{"brave-cats", "odd-dogs"},
+			},
+			wantPrerelease: "next",
+			wantNote:       "app@1.4.0, web@2.0.0. 2 changesets",
+		},
+		{
+			name:     "leaving prerelease mode",
+			pre:      map[string]any{"mode": "exit", "tag": "rc"},
+			wantNote: "the next release is stable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := &detector.Result{
+				Tool: detector.ToolChangesets,
+				ConfigData: map[string]any{
+					"changelog":  "@changesets/cli/changelog",
+					"access":     "public",
+					"baseBranch": "develop",
+				},
+				Details: map[string]any{},
+			}
+			if tt.pre != nil {
+				result.Details["pre"] = tt.pre
+			}
+			conv, err := ConvertDetailed(result)
+			if err != nil {
+				t.Fatalf("ConvertDetailed() error = %v", err)
+			}
+			config := conv.Config
+
+			if config.Versioning.Prerelease != tt.wantPrerelease {
+				t.Errorf("Prerelease = %q, want %q", config.Versioning.Prerelease, tt.wantPrerelease)
+			}
+			if !strings.Contains(config.Versioning.Note, tt.wantNote) {
+				t.Errorf("Note = %q, want it to contain %q", config.Versioning.Note, tt.wantNote)
+			}
+			if !reflect.DeepEqual(config.Git.AllowedBranches, []string{"develop"}) {
+				t.Errorf("AllowedBranches = %v, want [develop]", config.Git.AllowedBranches)
+			}
+			if len(config.Plugins) != 1 || config.Plugins[0].Config["access"] != "public" {
+				t.Errorf("Plugins = %v, want npm with public access", config.Plugins)
+			}
+			if len(conv.Warnings) != 0 {
+				t.Errorf("Warnings = %v, want none", conv.Warnings)
+			}
+		})
+	}
+}
+
+func TestMapSemanticReleasePlugin_GitLab(t *testing.T) {
+	plugin := mapSemanticReleasePlugin("@semantic-release/gitlab", map[string]any{
+		"gitlabUrl": "https://gitlab.example.com/",
+		"assets": []any{
+			"dist/*.zip",
+			map[string]any{"path": "dist/app.tar.gz"},
+			map[string]any{"path": "dist/app.deb", "label": "Debian package", "filepath": "/packages/app.deb"},
+		},
+		"milestones": []any{"v1.0"},
+	})
+
+	want := map[string]any{
+		"host": "https://gitlab.example.com",
+		"assets": []any{
+			"dist/*.zip",
+			"dist/app.tar.gz",
+			map[string]any{"path": "dist/app.deb", "label": "Debian package", "file_path": "/packages/app.deb"},
+		},
+		"milestones": []any{"v1.0"},
+	}
+	if !reflect.DeepEqual(plugin.Config, want) {
+		t.Errorf("Config = %v, want %v", plugin.Config, want)
+	}
+
+	if plugin := mapSemanticReleasePlugin("@semantic-release/gitlab", nil); plugin.Config != nil {
+		t.Errorf("Config = %v, want nil", plugin.Config)
+	}
+}
+
+func TestSupportFor(t *testing.T) {
+	for _, tool := range detector.Tools() {
+		t.Run(string(tool), func(t *testing.T) {
+			support := SupportFor(tool)
+			if len(support.Converted) == 0 {
+				t.Errorf("SupportFor(%s) lists no converted settings", tool)
+			}
+			// A setting belongs to one list; its parenthesis says which parts
+			converted := make(map[string]bool)
+			for _, item := range support.Converted {
+				converted[strings.SplitN(item, " (", 2)[0]] = true
+			}
+			for _, item := range support.Manual {
+				if name := strings.SplitN(item, " (", 2)[0]; converted[name] {
+					t.Errorf("SupportFor(%s) lists %q as both converted and manual", tool, name)
+				}
+			}
+		})
+	}
+}
+
+func TestExample(t *testing.T) {
+	for _, tool := range detector.Tools() {
+		t.Run(string(tool), func(t *testing.T) {
+			example, conv, err := Example(tool)
+			if err != nil {
+				t.Fatalf("Example(%s) error = %v", tool, err)
+			}
+			if mapped, total := conv.SourceCoverage(example.ConfigData); mapped == 0 {
+				t.Errorf("Example(%s) maps none of its %d keys", tool, total)
+			}
+		})
+	}
+
+	if _, _, err := Example("custom"); !errors.Is(err, ErrUnsupportedTool) {
+		t.Errorf("Example(custom) error = %v, want ErrUnsupportedTool", err)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	const toolCustom detector.Tool = "custom"
+	defer delete(converters, toolCustom)
+
+	Register(Converter{
+		Tool: toolCustom,
+		Convert: func(*detector.Result) (*Conversion, error) {
+			return newConversion(NewDefaultConfig("v", "main")), nil
+		},
+		Support: Support{Converted: []string{"everything"}},
+	})
+
+	config, err := Convert(&detector.Result{Tool: toolCustom})
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+	if config.Versioning.TagPrefix != "v" {
+		t.Errorf("TagPrefix = %q, want v", config.Versioning.TagPrefix)
+	}
+	if got := SupportFor(toolCustom).Converted; len(got) != 1 {
+		t.Errorf("SupportFor() = %v, want one entry", got)
+	}
+
+	if _, err := Convert(&detector.Result{Tool: "unknown"}); err == nil {
+		t.Error("Convert() should fail for an unregistered tool")
+	}
+}
+
+func TestConvert_GoReleaser_HeaderFooter(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "footer.md"), []byte("Need help? {{ .ProjectName }} support"), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	conv, err := ConvertDetailed(&detector.Result{
+		Tool:       detector.ToolGoReleaser,
+		ConfigFile: filepath.Join(dir, ".goreleaser.yaml"),
+		ConfigData: map[string]any{
+			"release": map[string]any{
+				"header": "## {{ .Tag }} release",
+				"footer": map[string]any{
+					"from_file": map[string]any{"path": "footer.md"},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+
+	if got := conv.Config.Changelog.Header; got != "## {{.Tag}} release" {
+		t.Errorf("Header = %q", got)
+	}
+	if got := conv.Config.Changelog.Footer; got != "Need help? {{.ProjectName}} support" {
+		t.Errorf("Footer = %q", got)
+	}
+
+	conv, err = ConvertDetailed(&detector.Result{
+		Tool:       detector.ToolGoReleaser,
+		ConfigFile: filepath.Join(dir, ".goreleaser.yaml"),
+		ConfigData: map[string]any{
+			"release": map[string]any{
+				"footer": map[string]any{
+					"from_file": map[string]any{"path": "missing.md"},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+	if conv.Config.Changelog.Footer != "" || len(conv.Warnings) != 1 {
+		t.Errorf("Footer = %q, Warnings = %v, want empty footer and one warning", conv.Config.Changelog.Footer, conv.Warnings)
+	}
+}
+
+func TestCheckConsistency(t *testing.T) {
+	config := func(prefix string, branches ...string) *RelictaConfig {
+		return &RelictaConfig{
+			Versioning: VersioningConfig{TagPrefix: prefix},
+			Git:        GitConfig{AllowedBranches: branches},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		sources    []Source
+		wantFields []string
+	}{
+		{
+			name: "consistent",
+			sources: []Source{
+				{File: ".releaserc.json", Config: config("v", "main")},
+				{File: ".goreleaser.yaml", Config: config("v")},
+			},
+		},
+		{
+			name: "prefix and branches disagree",
+			sources: []Source{
+				{File: ".releaserc.json", Config: config("v", "main")},
+				{File: ".release-it.json", Config: config("", "master")},
+			},
+			wantFields: []string{"versioning.tag_prefix", "git.allowed_branches"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings := CheckConsistency(tt.sources)
+			var fields []string
+			for _, w := range warnings {
+				fields = append(fields, w.Field)
+				for _, src := range tt.sources {
+					if !strings.Contains(w.Message, src.File) {
+						t.Errorf("warning %q does not name %s", w.Message, src.File)
+					}
+				}
+			}
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("warning fields = %v, want %v", fields, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestCheckConsistency_OnlyMappedFields(t *testing.T) {
+	sources := func(releaserc map[string]any) []Source {
+		t.Helper()
+		var out []Source
+		for _, r := range []*detector.Result{
+			{Tool: detector.ToolSemanticRelease, ConfigFile: ".releaserc.json", ConfigData: releaserc},
+			{Tool: detector.ToolGoReleaser, ConfigFile: ".goreleaser.yaml", ConfigData: map[string]any{"project_name": "demo"}},
+		} {
+			conv, err := ConvertDetailed(r)
+			if err != nil {
+				t.Fatalf("ConvertDetailed() error = %v", err)
+			}
+			out = append(out, Source{File: r.ConfigFile, Config: conv.Config, Provenance: conv.Provenance})
+		}
+		return out
+	}
+
+	// No tagFormat: semantic-release's default and GoReleaser's assumed
+	// prefix are not compared, and neither are the default branches
+	if warnings := CheckConsistency(sources(map[string]any{"branches": []any{"master"}})); len(warnings) > 0 {
+		t.Errorf("CheckConsistency() = %v, want no warnings", warnings)
+	}
+
+	// Two configs that both set the prefix are still compared
+	first := sources(map[string]any{"tagFormat": "release-${version}"})[0]
+	second := sources(map[string]any{"tagFormat": "v${version}"})[0]
+	second.File = "package.json"
+	if warnings := CheckConsistency([]Source{first, second}); len(warnings) != 1 || warnings[0].Field != "versioning.tag_prefix" {
+		t.Errorf("CheckConsistency() = %v, want a tag prefix warning", warnings)
 	}
 }
@@ -0,0 +1,161 @@
+package converter
+
+import (
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/relicta-tech/migrate/internal/detector"
+)
+
+// envTemplatePattern matches a Go template environment lookup such as
+// GoReleaser's {{ .Env.GITHUB_TOKEN }}.
+var envTemplatePattern = regexp.MustCompile(`\{\{-?\s*\.Env\.([A-Za-z_][A-Za-z0-9_]*)\s*-?\}\}`)
+
+// shellEnvPattern matches a shell-style ${HOME} or $HOME reference. Only
+// upper-case names are taken as environment variables so source tool
+// placeholders such as ${version} are left alone.
+var shellEnvPattern = regexp.MustCompile(`\$\{([A-Z_][A-Z0-9_]*)\}|\$([A-Z_][A-Z0-9_]*)`)
+
+// bracedEnvPattern matches only the ${HOME} form of a shell reference.
+var bracedEnvPattern = regexp.MustCompile(`\$\{([A-Z_][A-Z0-9_]*)\}`)
+
+// bareVariableTools use $NAME for their own template variables, such as
+// release-drafter's $OWNER and $RESOLVED_VERSION, so only the ${NAME}
+// form is an environment variable in their configs.
+var bareVariableTools = map[detector.Tool]bool{
+	detector.ToolReleaseDrafter: true,
+}
+
+// checkEnv normalizes environment variable references in the converted
+// config to Relicta's {{.Env.NAME}} form, without expanding them, and
+// warns about the variables the config depends on. Hook commands keep
+// their shell syntax since a shell runs them; fields starting with "_"
+// are notes and left as they are. Maps and slices are copied before they
+// are rewritten, since they may be shared with the source config.
+func checkEnv(conv *Conversion, tool detector.Tool) {
+	e := &envWalker{seen: make(map[string]bool), pattern: shellEnvPattern}
+	if bareVariableTools[tool] {
+		e.pattern = bracedEnvPattern
+	}
+	e.walk(reflect.ValueOf(conv.Config).Elem(), false)
+	if len(e.seen) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(e.seen))
+	for name := range e.seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	conv.warn("env", "the migrated config reads environment variables %s; make sure they are set where Relicta runs", strings.Join(names, ", "))
+}
+
+// envWalker holds the state of one checkEnv: the shell reference pattern
+// of the source tool and the variables seen so far.
+type envWalker struct {
+	pattern *regexp.Regexp
+	seen    map[string]bool
+}
+
+// walk normalizes the strings reachable from v.
+func (e *envWalker) walk(v reflect.Value, shell bool) {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if !v.IsNil() {
+			e.walk(v.Elem(), shell)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			key, _, _ := strings.Cut(t.Field(i).Tag.Get("yaml"), ",")
+			if strings.HasPrefix(key, "_") {
+				continue
+			}
+			e.walk(v.Field(i), shell || t.Field(i).Type == reflect.TypeOf([]HookConfig(nil)))
+		}
+	case reflect.Slice:
+		if v.IsNil() {
+			return
+		}
+		copied := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		reflect.Copy(copied, v)
+		v.Set(copied)
+		for i := 0; i < v.Len(); i++ {
+			e.walk(v.Index(i), shell)
+		}
+	case reflect.Map:
+		if m, ok := v.Interface().(map[string]any); ok && m != nil {
+			v.Set(reflect.ValueOf(e.envMap(m, shell)))
+		}
+	case reflect.Interface:
+		if !v.IsNil() {
+			if value := e.envValue(v.Interface(), shell); value != nil {
+				v.Set(reflect.ValueOf(value))
+			}
+		}
+	case reflect.String:
+		v.SetString(e.normalize(v.String(), shell))
+	}
+}
+
+// envMap returns a copy of a free-form config map with its values
+// normalized.
+func (e *envWalker) envMap(m map[string]any, shell bool) map[string]any {
+	result := make(map[string]any, len(m))
+	for key, value := range m {
+		if strings.HasPrefix(key, "_") {
+			result[key] = value
+		} else {
+			result[key] = e.envValue(value, shell)
+		}
+	}
+	return result
+}
+
+// envValue returns a free-form config value normalized, copying it if it
+// is a map or slice.
+func (e *envWalker) envValue(value any, shell bool) any {
+	switch v := value.(type) {
+	case string:
+		return e.normalize(v, shell)
+	case []string:
+		result := make([]string, len(v))
+		for i := range v {
+			result[i] = e.normalize(v[i], shell)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i := range v {
+			result[i] = e.envValue(v[i], shell)
+		}
+		return result
+	case map[string]any:
+		return e.envMap(v, shell)
+	}
+	return value
+}
+
+// normalize records the environment variables s references and rewrites
+// them as {{.Env.NAME}}. Shell references are kept as they are when
+// shell is set.
+func (e *envWalker) normalize(s string, shell bool) string {
+	if !strings.Contains(s, "$") && !strings.Contains(s, ".Env.") {
+		return s
+	}
+	s = envTemplatePattern.ReplaceAllStringFunc(s, func(m string) string {
+		name := envTemplatePattern.FindStringSubmatch(m)[1]
+		e.seen[name] = true
+		return "{{.Env." + name + "}}"
+	})
+	return e.pattern.ReplaceAllStringFunc(s, func(m string) string {
+		name := strings.Join(e.pattern.FindStringSubmatch(m)[1:], "")
+		e.seen[name] = true
+		if shell {
+			return m
+		}
+		return "{{.Env." + name + "}}"
+	})
+}
@@ -0,0 +1,127 @@
+package converter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/relicta-tech/migrate/internal/detector"
+)
+
+func TestCheckEnv(t *testing.T) {
+	conv, err := ConvertDetailed(&detector.Result{
+		Tool: detector.ToolSemanticRelease,
+		ConfigData: map[string]any{
+			"tagFormat": "v${version}",
+			"plugins": []any{
+				[]any{"@semantic-release/npm", map[string]any{"pkgRoot": "${PKG_DIR}/dist"}},
+				[]any{"@semantic-release/exec", map[string]any{"publishCmd": "$HOME/bin/publish ${nextRelease.version}"}},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+
+	var npm PluginConfig
+	for _, p := range conv.Config.Plugins {
+		if p.Name == "npm" {
+			npm = p
+		}
+	}
+	if got := npm.Config["package_root"]; got != "{{.Env.PKG_DIR}}/dist" {
+		t.Errorf("package_root = %v, want {{.Env.PKG_DIR}}/dist", got)
+	}
+	if got := conv.Config.Hooks[0].Command; got != "$HOME/bin/publish {{.Version}}" {
+		t.Errorf("hook command = %q, want the shell reference kept", got)
+	}
+	if conv.Config.Versioning.TagPrefix != "v" {
+		t.Errorf("TagPrefix = %q, want v", conv.Config.Versioning.TagPrefix)
+	}
+
+	var env []Warning
+	for _, w := range conv.Warnings {
+		if w.Field == "env" {
+			env = append(env, w)
+		}
+	}
+	if len(env) != 1 || !strings.Contains(env[0].Message, "HOME, PKG_DIR") {
+		t.Errorf("env warnings = %v, want one listing HOME, PKG_DIR", env)
+	}
+}
+
+func TestNormalizeEnv(t *testing.T) {
+	tests := []struct {
+		in    string
+		shell bool
+		want  string
+		names []string
+	}{
+		{"{{ .Env.GITHUB_TOKEN }}", false, "{{.Env.GITHUB_TOKEN}}", []string{"GITHUB_TOKEN"}},
+		{"${HOME}/.cache", false, "{{.Env.HOME}}/.cache", []string{"HOME"}},
+		{"${HOME}/.cache", true, "${HOME}/.cache", []string{"HOME"}},
+		{"v${version}", false, "v${version}", nil},
+		{"$artifact", false, "$artifact", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			seen := make(map[string]bool)
+			e := &envWalker{pattern: shellEnvPattern, seen: seen}
+			if got := e.normalize(tt.in, tt.shell); got != tt.want {
+				t.Errorf("normalize() = %q, want %q", got, tt.want)
+			}
+			if len(seen) != len(tt.names) {
+				t.Errorf("seen = %v, want %v", seen, tt.names)
+			}
+			for _, name := range tt.names {
+				if !seen[name] {
+					t.Errorf("seen = %v, missing %s", seen, name)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckEnv_SourceUnchanged(t *testing.T) {
+	npm := map[string]any{"pkgRoot": "${PKG_DIR}/dist"}
+	assets := []any{"${DIST}/*.tgz"}
+	data := map[string]any{
+		"plugins": []any{
+			[]any{"@semantic-release/npm", npm},
+			[]any{"@semantic-release/github", map[string]any{"assets": assets}},
+		},
+	}
+	if _, err := ConvertDetailed(&detector.Result{Tool: detector.ToolSemanticRelease, ConfigData: data}); err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+
+	if got := npm["pkgRoot"]; got != "${PKG_DIR}/dist" {
+		t.Errorf("source pkgRoot = %v, want it unchanged", got)
+	}
+	if !reflect.DeepEqual(assets, []any{"${DIST}/*.tgz"}) {
+		t.Errorf("source assets = %v, want them unchanged", assets)
+	}
+}
+
+func TestCheckEnv_ReleaseDrafterVariables(t *testing.T) {
+	conv, err := ConvertDetailed(&detector.Result{
+		Tool: detector.ToolReleaseDrafter,
+		ConfigData: map[string]any{
+			"name-template": "$OWNER/$REPOSITORY v$RESOLVED_VERSION ${BUILD_ID}",
+		},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+
+	gh := conv.Config.Plugins[pluginIndex(conv.Config.Plugins, "github")]
+	if got := gh.Config["name_template"]; got != "$OWNER/$REPOSITORY v{{.Version}} {{.Env.BUILD_ID}}" {
+		t.Errorf("name_template = %v, want release-drafter variables kept", got)
+	}
+	for _, w := range conv.Warnings {
+		if w.Field == "env" && (strings.Contains(w.Message, "OWNER") || !strings.Contains(w.Message, "BUILD_ID")) {
+			t.Errorf("env warning = %q, want only BUILD_ID", w.Message)
+		}
+	}
+}
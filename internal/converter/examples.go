@@ -0,0 +1,151 @@
+package converter
+
+import "github.com/relicta-tech/migrate/internal/detector"
+
+// Example configs exercise the settings each converter maps. Converting
+// one records where every Relicta field comes from, which is how
+// `migrate explain <tool>` derives its mapping rules from the converters
+// themselves rather than from a separate table. Keep them in step with
+// the converters: a mapped setting missing here is missing from explain.
+
+func semanticReleaseExample() *detector.Result {
+	return &detector.Result{
+		ConfigFile: ".releaserc.json",
+		ConfigData: map[string]any{
+			"tagFormat": "v${version}",
+			"branches":  []any{"main", map[string]any{"name": "1.x", "range": "1.x", "channel": "1.x"}, map[string]any{"name": "beta", "prerelease": true}},
+			"plugins": []any{
+				[]any{"@semantic-release/commit-analyzer", map[string]any{
+					"preset":       "conventionalcommits",
+					"releaseRules": []any{map[string]any{"type": "docs", "release": "patch"}},
+				}},
+				[]any{"@semantic-release/release-notes-generator", map[string]any{
+					"presetConfig": map[string]any{"types": []any{map[string]any{"type": "feat", "section": "Features"}}},
+				}},
+				[]any{"@semantic-release/exec", map[string]any{"prepareCmd": "make dist VERSION=${nextRelease.version}"}},
+				"@semantic-release/npm",
+				[]any{"@semantic-release/github", map[string]any{"assets": []any{"dist/*.tgz"}}},
+			},
+		},
+		Details: map[string]any{"packageName": "my-package"},
+	}
+}
+
+func releaseItExample() *detector.Result {
+	return &detector.Result{
+		ConfigFile: ".release-it.json",
+		ConfigData: map[string]any{
+			"git": map[string]any{
+				"tagName":                "v${version}",
+				"commitMessage":          "chore: release v${version}",
+				"tagAnnotation":          "Release ${version}",
+				"requireCleanWorkingDir": true,
+				"push":                   true,
+				"changelog":              false,
+				"tagExclude":             "*-nightly*",
+			},
+			"increment":    "conventional:angular",
+			"preReleaseId": "beta",
+			"github":       map[string]any{"release": true, "draft": false, "assets": []any{"dist/*.zip"}},
+			"npm":          map[string]any{"publish": true, "publishPath": "dist", "versionArgs": []any{"--allow-same-version"}},
+			"plugins": map[string]any{
+				"@release-it/bumper": map[string]any{"out": []any{"VERSION"}},
+			},
+			"hooks": map[string]any{"after:bump": []any{"npm run build", "npm run docs"}},
+		},
+		Details: map[string]any{"packageName": "my-package"},
+	}
+}
+
+func standardVersionExample() *detector.Result {
+	return &detector.Result{
+		ConfigFile: ".versionrc.json",
+		ConfigData: map[string]any{
+			"tagPrefix":                  "v",
+			"skip":                       map[string]any{"changelog": false, "tag": true},
+			"releaseCommitMessageFormat": "chore(release): {{currentTag}}",
+			"infile":                     "HISTORY.md",
+			"preset":                     "conventionalcommits",
+			"header":                     "# Changelog",
+			"types":                      []any{map[string]any{"type": "feat", "section": "Features"}},
+			"commitUrlFormat":            "{{host}}/{{owner}}/{{repository}}/commit/{{hash}}",
+			"issueUrlFormat":             "{{host}}/{{owner}}/{{repository}}/issues/{{id}}",
+			"scripts":                    map[string]any{"prebump": "date +%Y.%m.%d"},
+		},
+		Details: map[string]any{"packageName": "my-package"},
+	}
+}
+
+func goReleaserExample() *detector.Result {
+	return &detector.Result{
+		ConfigFile: ".goreleaser.yaml",
+		ConfigData: map[string]any{
+			"version":      2,
+			"project_name": "mytool",
+			"builds": []any{map[string]any{
+				"goos":    []any{"linux", "darwin"},
+				"goarch":  []any{"amd64", "arm64"},
+				"env":     []any{"CGO_ENABLED=0"},
+				"ldflags": []any{"-s -w -X main.version={{.Version}}"},
+			}},
+			"gomod":      map[string]any{"proxy": true},
+			"metadata":   map[string]any{"mod_timestamp": "{{ .CommitTimestamp }}"},
+			"archives":   []any{map[string]any{"formats": []any{"tar.gz"}, "files": []any{"LICENSE", "completions/*"}}},
+			"checksum":   map[string]any{"algorithm": "sha256"},
+			"changelog":  map[string]any{"disable": true, "use": "github"},
+			"kos":        []any{map[string]any{"repository": "ghcr.io/acme/mytool", "tags": []any{"{{ .Tag }}", "latest"}, "platforms": []any{"linux/amd64", "linux/arm64"}}},
+			"milestones": []any{map[string]any{"close": true, "name_template": "{{ .Tag }}"}},
+			"release": map[string]any{
+				"github": map[string]any{"owner": "acme", "name": "mytool"},
+				"draft":  true,
+				"header": "## mytool {{ .Tag }}",
+				"footer": "Thanks to all contributors!",
+			},
+			"snapshot": map[string]any{"version_template": "{{ incpatch .Version }}-next"},
+			"git":      map[string]any{"tag_sort": "semver"},
+		},
+		Details: map[string]any{"defaultBranch": "main"},
+	}
+}
+
+func releaseDrafterExample() *detector.Result {
+	return &detector.Result{
+		ConfigFile: ".github/release-drafter.yml",
+		ConfigData: map[string]any{
+			"name-template": "v$RESOLVED_VERSION",
+			"tag-template":  "v$RESOLVED_VERSION",
+			"categories":    []any{map[string]any{"title": "Features", "labels": []any{"feature", "enhancement"}}},
+			"version-resolver": map[string]any{
+				"major": map[string]any{"labels": []any{"breaking"}},
+				"minor": map[string]any{"labels": []any{"feature"}},
+			},
+		},
+	}
+}
+
+func mavenReleaseExample() *detector.Result {
+	return &detector.Result{
+		ConfigFile: "pom.xml",
+		ConfigData: map[string]any{
+			"artifactId":    "demo",
+			"tagNameFormat": "v@{project.version}",
+			"pushChanges":   true,
+			"scm":           map[string]any{"url": "https://github.com/acme/demo"},
+		},
+	}
+}
+
+func changesetsExample() *detector.Result {
+	return &detector.Result{
+		ConfigFile: ".changeset/config.json",
+		ConfigData: map[string]any{
+			"changelog":  "@changesets/cli/changelog",
+			"access":     "public",
+			"baseBranch": "main",
+		},
+		Details: map[string]any{
+			"packageName": "my-package",
+			"pre":         map[string]any{"mode": "pre", "tag": "next", "initialVersions": map[string]any{"my-package": "1.4.0"}},
+		},
+	}
+}
@@ -0,0 +1,288 @@
+package converter
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/relicta-tech/migrate/internal/detector"
+)
+
+// ToolConversion is a conversion together with the tool it was converted
+// from.
+type ToolConversion struct {
+	Tool       detector.Tool
+	Conversion *Conversion
+}
+
+// artifactTools build and publish release artifacts rather than decide
+// the version. They take precedence in artifactSections, and the other
+// tools everywhere else.
+var artifactTools = map[detector.Tool]bool{
+	detector.ToolGoReleaser: true,
+}
+
+// artifactSections are the config sections artifact tools own by default.
+var artifactSections = map[string]bool{
+	"plugins": true,
+}
+
+// Merge combines the conversions of several tools configured in the same
+// repository, such as semantic-release for versioning and GoReleaser for
+// artifacts, into the first one. Later conversions fill in the fields
+// that earlier ones only defaulted or assumed, and add the plugins and
+// plugin settings they lack.
+//
+// A field or plugin setting converted from more than one source config
+// to different values is taken from the tool that owns its top-level
+// section (e.g. "versioning" or "plugins"), with a warning. prefer names
+// the owner of a section; otherwise artifact tools such as GoReleaser own
+// the plugins and the other tools own the rest, ties going to the earlier
+// conversion. The warnings of every conversion are kept.
+func Merge(convs []ToolConversion, prefer map[string]detector.Tool) *Conversion {
+	if len(convs) == 0 {
+		return nil
+	}
+	m := &merger{
+		merged: convs[0].Conversion,
+		prefer: prefer,
+		order:  make(map[detector.Tool]int, len(convs)),
+		owner:  make(map[string]detector.Tool),
+	}
+	for i := len(convs) - 1; i >= 0; i-- {
+		m.order[convs[i].Tool] = i
+	}
+	for field := range m.merged.Provenance {
+		m.owner[field] = convs[0].Tool
+	}
+	for _, plugin := range m.merged.Config.Plugins {
+		m.owner["plugins."+plugin.Name] = convs[0].Tool
+	}
+
+	for _, tc := range convs[1:] {
+		m.mergeFields(tc)
+		m.mergePlugins(tc)
+		m.merged.Warnings = append(m.merged.Warnings, tc.Conversion.Warnings...)
+		for key := range tc.Conversion.consumed {
+			m.merged.consume(key)
+		}
+	}
+	return m.merged
+}
+
+// ParseMergePrefer reads section=tool pairs, as given to --merge-prefer,
+// into the prefer argument of Merge. Sections are the top-level keys of
+// the Relicta config.
+func ParseMergePrefer(values []string) (map[string]detector.Tool, error) {
+	prefer := make(map[string]detector.Tool, len(values))
+	config := reflect.New(reflect.TypeOf(RelictaConfig{})).Elem()
+	for _, value := range values {
+		section, name, ok := strings.Cut(value, "=")
+		if !ok {
+			return nil, fmt.Errorf("%q: want section=tool", value)
+		}
+		section = strings.TrimSpace(section)
+		if _, ok := fieldByYAMLName(config, section); !ok || strings.HasPrefix(section, "_") {
+			return nil, fmt.Errorf("%q: unknown section %q", value, section)
+		}
+		tool, err := detector.ParseTool(strings.TrimSpace(name))
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", value, err)
+		}
+		prefer[section] = tool
+	}
+	return prefer, nil
+}
+
+// merger holds the state of one Merge: the conversion merged into, and
+// which tool each field and plugin setting was taken from.
+type merger struct {
+	merged *Conversion
+	prefer map[string]detector.Tool
+	order  map[detector.Tool]int
+	owner  map[string]detector.Tool
+}
+
+// rank orders tools for a section: the preferred tool, then tools whose
+// role owns the section, then the rest.
+func (m *merger) rank(section string, tool detector.Tool) int {
+	switch {
+	case m.prefer[section] == tool:
+		return 0
+	case artifactSections[section] == artifactTools[tool]:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// wins reports whether tool takes precedence over current in the section
+// field belongs to.
+func (m *merger) wins(field string, tool, current detector.Tool) bool {
+	section, _, _ := strings.Cut(field, ".")
+	if a, b := m.rank(section, tool), m.rank(section, current); a != b {
+		return a < b
+	}
+	return m.order[tool] < m.order[current]
+}
+
+// isMapped reports whether a provenance note records a source config key
+// rather than a default or an assumption.
+func isMapped(note string) bool {
+	return strings.HasPrefix(note, "from ")
+}
+
+// mergeFields copies the fields tc mapped from its source config into the
+// merged config, unless they were mapped there already and the earlier
+// tool takes precedence.
+func (m *merger) mergeFields(tc ToolConversion) {
+	fields := make([]string, 0, len(tc.Conversion.Provenance))
+	for field, note := range tc.Conversion.Provenance {
+		if isMapped(note) && field != "plugins" && !strings.HasPrefix(field, "plugins.") {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	merged := m.merged
+	for _, field := range fields {
+		parts := strings.Split(field, ".")
+		from, ok := fieldAt(reflect.ValueOf(tc.Conversion.Config).Elem(), parts, false)
+		if !ok {
+			continue
+		}
+		if isMapped(merged.Provenance[field]) {
+			to, _ := fieldAt(reflect.ValueOf(merged.Config).Elem(), parts, false)
+			if !to.IsValid() || reflect.DeepEqual(to.Interface(), from.Interface()) {
+				continue
+			}
+			current := m.owner[field]
+			kept := current
+			if m.wins(field, tc.Tool, current) {
+				kept = tc.Tool
+			}
+			merged.warn(field, "%s and %s disagree (%v and %v); kept the %s value",
+				current, tc.Tool, to.Interface(), from.Interface(), kept)
+			if kept == current {
+				continue
+			}
+		}
+		to, ok := fieldAt(reflect.ValueOf(merged.Config).Elem(), parts, true)
+		if !ok {
+			continue
+		}
+		to.Set(from)
+		merged.Provenance[field] = tc.Conversion.Provenance[field]
+		m.owner[field] = tc.Tool
+	}
+}
+
+// mergePlugins adds the plugins of tc that the merged config lacks, and
+// the settings of plugins both have. A setting both give different
+// values is taken from the tool that owns the plugins section. Added
+// plugins get their own settings map, so tc's conversion is left as it
+// was.
+func (m *merger) mergePlugins(tc ToolConversion) {
+	merged := m.merged
+	for _, plugin := range tc.Conversion.Config.Plugins {
+		key := "plugins." + plugin.Name
+		i := pluginIndex(merged.Config.Plugins, plugin.Name)
+		if i < 0 {
+			plugin.Config = maps.Clone(plugin.Config)
+			merged.Config.Plugins = append(merged.Config.Plugins, plugin)
+			m.owner[key] = tc.Tool
+			copyProvenance(merged, tc.Conversion, key)
+			continue
+		}
+
+		existing := &merged.Config.Plugins[i]
+		if existing.Enabled != plugin.Enabled && m.resolve(key+".enabled", key, existing.Enabled, plugin.Enabled, tc.Tool) {
+			existing.Enabled = plugin.Enabled
+		}
+		names := make([]string, 0, len(plugin.Config))
+		for name := range plugin.Config {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			field := key + ".config." + name
+			value, ok := existing.Config[name]
+			switch {
+			case reflect.DeepEqual(value, plugin.Config[name]):
+				continue
+			case ok && !m.resolve(field, key, value, plugin.Config[name], tc.Tool):
+				continue
+			}
+			if existing.Config == nil {
+				existing.Config = make(map[string]any)
+			}
+			existing.Config[name] = plugin.Config[name]
+			copyProvenance(merged, tc.Conversion, field)
+		}
+	}
+}
+
+// resolve settles a plugin setting both the merged config and tool give
+// different values, warning about it, and reports whether tool's value
+// wins. The setting's owner defaults to the owner of its plugin.
+func (m *merger) resolve(field, plugin string, current, value any, tool detector.Tool) bool {
+	owner, ok := m.owner[field]
+	if !ok {
+		owner = m.owner[plugin]
+	}
+	kept := owner
+	if m.wins(field, tool, owner) {
+		kept = tool
+		m.owner[field] = tool
+	}
+	m.merged.warn(field, "%s and %s disagree (%v and %v); kept the %s value", owner, tool, current, value, kept)
+	return kept == tool
+}
+
+// copyProvenance copies the provenance of field, and of the fields below
+// it, from conv to merged.
+func copyProvenance(merged, conv *Conversion, field string) {
+	for f, note := range conv.Provenance {
+		if f == field || strings.HasPrefix(f, field+".") {
+			merged.Provenance[f] = note
+		}
+	}
+}
+
+// pluginIndex returns the index of the plugin called name, or -1.
+func pluginIndex(plugins []PluginConfig, name string) int {
+	for i, plugin := range plugins {
+		if plugin.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// fieldAt returns the settable field at a dotted YAML path of a config
+// struct. Nil pointers along the way end the walk, or are allocated when
+// create is set.
+func fieldAt(v reflect.Value, parts []string, create bool) (reflect.Value, bool) {
+	for _, part := range parts {
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				if !create {
+					return reflect.Value{}, false
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+		field, ok := fieldByYAMLName(v, part)
+		if !ok {
+			return reflect.Value{}, false
+		}
+		v = field
+	}
+	return v, true
+}
@@ -0,0 +1,156 @@
+package converter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/relicta-tech/migrate/internal/detector"
+)
+
+func TestMerge(t *testing.T) {
+	convert := func(tool detector.Tool, data map[string]any) ToolConversion {
+		t.Helper()
+		conv, err := ConvertDetailed(&detector.Result{Tool: tool, ConfigData: data})
+		if err != nil {
+			t.Fatalf("ConvertDetailed(%s) error = %v", tool, err)
+		}
+		return ToolConversion{Tool: tool, Conversion: conv}
+	}
+
+	semanticRelease := convert(detector.ToolSemanticRelease, map[string]any{
+		"branches":  []any{"main"},
+		"tagFormat": "release-${version}",
+		"plugins": []any{
+			"@semantic-release/commit-analyzer",
+			"@semantic-release/github",
+		},
+	})
+	goReleaser := convert(detector.ToolGoReleaser, map[string]any{
+		"project_name": "demo",
+		"changelog":    map[string]any{"use": "github"},
+		"checksum":     map[string]any{"algorithm": "sha256"},
+		"release":      map[string]any{"draft": true},
+	})
+
+	merged := Merge([]ToolConversion{semanticRelease, goReleaser}, nil)
+
+	if got := merged.Config.Versioning.TagPrefix; got != "release-" {
+		t.Errorf("TagPrefix = %q, want semantic-release's release-", got)
+	}
+	if got := merged.Config.Changelog.Source; got != "github" {
+		t.Errorf("Changelog.Source = %q, want github from GoReleaser", got)
+	}
+	if got := merged.Provenance["changelog.source"]; got != "from changelog.use" {
+		t.Errorf("Provenance[changelog.source] = %q", got)
+	}
+	if pluginIndex(merged.Config.Plugins, "checksum") < 0 {
+		t.Errorf("Plugins = %+v, want GoReleaser's checksum plugin", merged.Config.Plugins)
+	}
+	if merged.Provenance["plugins.checksum"] == "" {
+		t.Error("Provenance[plugins.checksum] was not carried over")
+	}
+
+	// Both convert a github plugin; GoReleaser adds the draft setting
+	gh := merged.Config.Plugins[pluginIndex(merged.Config.Plugins, "github")]
+	if gh.Config["draft"] != true {
+		t.Errorf("github plugin = %+v, want draft from GoReleaser", gh)
+	}
+	for _, w := range merged.Warnings {
+		if strings.Contains(w.Message, "disagree") {
+			t.Errorf("unexpected conflict warning %v", w)
+		}
+	}
+}
+
+func TestMerge_Conflicts(t *testing.T) {
+	convs := func() []ToolConversion {
+		semanticRelease := newConversion(NewDefaultConfig("v", "main"))
+		semanticRelease.mapped("versioning.tag_prefix", "tagFormat")
+		semanticRelease.Config.Plugins = []PluginConfig{{Name: "github", Enabled: true, Config: map[string]any{
+			"assets": []any{"build/*.tgz"},
+			"draft":  false,
+		}}}
+		goReleaser := newConversion(NewDefaultConfig("app-v", "main"))
+		goReleaser.mapped("versioning.tag_prefix", "git.tag_prefix")
+		goReleaser.Config.Plugins = []PluginConfig{{Name: "github", Enabled: true, Config: map[string]any{
+			"assets":     []any{"dist/*.tar.gz"},
+			"draft":      false,
+			"discussion": "Announcements",
+		}}}
+		return []ToolConversion{
+			{Tool: detector.ToolSemanticRelease, Conversion: semanticRelease},
+			{Tool: detector.ToolGoReleaser, Conversion: goReleaser},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		prefer     map[string]detector.Tool
+		wantPrefix string
+		wantAssets []any
+	}{
+		{
+			name:       "versioning from semantic-release, plugins from GoReleaser",
+			wantPrefix: "v",
+			wantAssets: []any{"dist/*.tar.gz"},
+		},
+		{
+			name:       "preferred sections",
+			prefer:     map[string]detector.Tool{"versioning": detector.ToolGoReleaser, "plugins": detector.ToolSemanticRelease},
+			wantPrefix: "app-v",
+			wantAssets: []any{"build/*.tgz"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged := Merge(convs(), tt.prefer)
+
+			if got := merged.Config.Versioning.TagPrefix; got != tt.wantPrefix {
+				t.Errorf("TagPrefix = %q, want %q", got, tt.wantPrefix)
+			}
+			gh := merged.Config.Plugins[0]
+			if !reflect.DeepEqual(gh.Config["assets"], tt.wantAssets) {
+				t.Errorf("assets = %v, want %v", gh.Config["assets"], tt.wantAssets)
+			}
+			if gh.Config["discussion"] != "Announcements" {
+				t.Errorf("github plugin = %+v, want GoReleaser's discussion added", gh)
+			}
+			var fields []string
+			for _, w := range merged.Warnings {
+				fields = append(fields, w.Field)
+			}
+			if strings.Join(fields, " ") != "versioning.tag_prefix plugins.github.config.assets" {
+				t.Errorf("Warnings = %v, want conflicts on versioning.tag_prefix and the github assets", merged.Warnings)
+			}
+		})
+	}
+}
+
+func TestParseMergePrefer(t *testing.T) {
+	prefer, err := ParseMergePrefer([]string{"plugins=goreleaser", "versioning = semantic-release"})
+	if err != nil {
+		t.Fatalf("ParseMergePrefer() error = %v", err)
+	}
+	want := map[string]detector.Tool{"plugins": detector.ToolGoReleaser, "versioning": detector.ToolSemanticRelease}
+	if !reflect.DeepEqual(prefer, want) {
+		t.Errorf("ParseMergePrefer() = %v, want %v", prefer, want)
+	}
+
+	for _, value := range []string{"plugins", "assets=goreleaser", "plugins=make"} {
+		if _, err := ParseMergePrefer([]string{value}); err == nil {
+			t.Errorf("ParseMergePrefer(%q) error = nil, want an error", value)
+		}
+	}
+}
+
+func TestMerge_Single(t *testing.T) {
+	conv := newConversion(NewDefaultConfig("v", "main"))
+	if got := Merge([]ToolConversion{{Tool: detector.ToolReleaseIt, Conversion: conv}}, nil); got != conv {
+		t.Error("Merge() of one conversion should return it unchanged")
+	}
+	if got := Merge(nil, nil); got != nil {
+		t.Errorf("Merge(nil) = %v, want nil", got)
+	}
+}
@@ -0,0 +1,67 @@
+package converter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// PluginMapping tells the semantic-release converter which Relicta plugin
+// a source plugin becomes, such as an organization's fork of a plugin.
+type PluginMapping struct {
+	// Name is the Relicta plugin name. An empty name drops the plugin, as
+	// for plugins whose job Relicta does itself.
+	Name string `json:"name"`
+	// Enabled sets whether the plugin is enabled; nil means enabled.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// UnmarshalJSON accepts either a plugin name or a {"name", "enabled"}
+// object.
+func (m *PluginMapping) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte(`"`)) {
+		*m = PluginMapping{}
+		return json.Unmarshal(data, &m.Name)
+	}
+	type plain PluginMapping
+	return json.Unmarshal(data, (*plain)(m))
+}
+
+// pluginMappings holds the registered plugin mappings by source plugin name.
+var pluginMappings = make(map[string]PluginMapping)
+
+// RegisterPluginMapping maps the source plugin named source, as written in
+// the config (e.g. "@ourco/semantic-release-jira"). Registered mappings
+// take precedence over the built-in ones. Like Register it is not safe for
+// concurrent use with conversion.
+func RegisterPluginMapping(source string, m PluginMapping) {
+	pluginMappings[source] = m
+}
+
+// ParsePluginMap reads a JSON object mapping source plugin names to a
+// Relicta plugin name or to a {"name", "enabled"} object:
+//
+//	{
+//	  "@ourco/semantic-release-jira": "jira",
+//	  "@ourco/semantic-release-slack": {"name": "slack", "enabled": false}
+//	}
+func ParsePluginMap(data []byte) (map[string]PluginMapping, error) {
+	var m map[string]PluginMapping
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("invalid plugin map: %w", err)
+	}
+	return m, nil
+}
+
+// plugin builds the Relicta plugin for a mapped source plugin, or nil when
+// the mapping drops it.
+func (m PluginMapping) plugin(config map[string]any) *PluginConfig {
+	if m.Name == "" {
+		return nil
+	}
+	return &PluginConfig{
+		Name:    m.Name,
+		Enabled: m.Enabled == nil || *m.Enabled,
+		Config:  config,
+	}
+}
@@ -0,0 +1,72 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/relicta-tech/migrate/internal/detector"
+)
+
+func TestParsePluginMap(t *testing.T) {
+	m, err := ParsePluginMap([]byte(`{
+		"@ourco/semantic-release-jira": "jira",
+		"@ourco/semantic-release-slack": {"name": "slack", "enabled": false}
+	}`))
+	if err != nil {
+		t.Fatalf("ParsePluginMap() error = %v", err)
+	}
+	if got := m["@ourco/semantic-release-jira"]; got.Name != "jira" || got.Enabled != nil {
+		t.Errorf("jira mapping = %+v, want name jira", got)
+	}
+	if got := m["@ourco/semantic-release-slack"]; got.Name != "slack" || got.Enabled == nil || *got.Enabled {
+		t.Errorf("slack mapping = %+v, want disabled slack", got)
+	}
+
+	if _, err := ParsePluginMap([]byte(`["jira"]`)); err == nil {
+		t.Error("ParsePluginMap() error = nil, want an error for a list")
+	}
+}
+
+func TestConvert_SemanticRelease_PluginMapping(t *testing.T) {
+	disabled := false
+	mappings := map[string]PluginMapping{
+		"@ourco/semantic-release-jira": {Name: "jira"},
+		"@ourco/semantic-release-noop": {},
+		"@semantic-release/npm":        {Name: "registry", Enabled: &disabled},
+	}
+	for source, m := range mappings {
+		RegisterPluginMapping(source, m)
+	}
+	t.Cleanup(func() {
+		for source := range mappings {
+			delete(pluginMappings, source)
+		}
+	})
+
+	conv, err := ConvertDetailed(&detector.Result{
+		Tool: detector.ToolSemanticRelease,
+		ConfigData: map[string]any{
+			"plugins": []any{
+				[]any{"@ourco/semantic-release-jira", map[string]any{"project": "OPS"}},
+				"@ourco/semantic-release-noop",
+				"@semantic-release/npm",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ConvertDetailed() error = %v", err)
+	}
+
+	plugins := conv.Config.Plugins
+	if len(plugins) != 2 {
+		t.Fatalf("Plugins = %+v, want jira and registry", plugins)
+	}
+	if plugins[0].Name != "jira" || !plugins[0].Enabled || plugins[0].Config["project"] != "OPS" {
+		t.Errorf("Plugins[0] = %+v, want enabled jira with its config", plugins[0])
+	}
+	if plugins[1].Name != "registry" || plugins[1].Enabled {
+		t.Errorf("Plugins[1] = %+v, want disabled registry overriding the built-in npm mapping", plugins[1])
+	}
+	if got := conv.Provenance["plugins.jira"]; got != "from plugins[@ourco/semantic-release-jira]" {
+		t.Errorf("Provenance[plugins.jira] = %q", got)
+	}
+}
@@ -0,0 +1,129 @@
+package converter
+
+import (
+	"fmt"
+
+	"github.com/relicta-tech/migrate/internal/detector"
+)
+
+// Converter translates one source tool's configuration to Relicta. It is
+// the counterpart of a detector.Detector for the same tool.
+type Converter struct {
+	Tool    detector.Tool
+	Convert func(*detector.Result) (*Conversion, error)
+	// Support describes the coverage of Convert for users.
+	Support Support
+}
+
+// ErrUnsupportedTool is returned by ConvertDetailed for a tool with no
+// registered Converter. It is the same error as detector.ErrUnsupportedTool.
+var ErrUnsupportedTool = detector.ErrUnsupportedTool
+
+// Support describes which source settings a converter translates and
+// which are only flagged for manual migration.
+type Support struct {
+	Converted []string
+	Manual    []string
+	// Example returns a representative source config. Converting it, as
+	// the Example function does, shows which Relicta field each setting
+	// maps to.
+	Example func() *detector.Result
+}
+
+// converters holds the registered converters by tool.
+var converters = make(map[detector.Tool]Converter)
+
+// Register adds the converter for a tool, replacing any earlier one.
+// Register is meant to be called from init functions and is not safe for
+// concurrent use with conversion.
+func Register(c Converter) {
+	converters[c.Tool] = c
+}
+
+// SupportFor describes which settings of tool are converted.
+func SupportFor(tool detector.Tool) Support {
+	return converters[tool].Support
+}
+
+// Example converts the example config of tool, so that the conversion's
+// mappings and assumptions describe how the converter treats each
+// setting. It returns the example it converted along with the result.
+func Example(tool detector.Tool) (*detector.Result, *Conversion, error) {
+	c, ok := converters[tool]
+	if !ok || c.Support.Example == nil {
+		return nil, nil, fmt.Errorf("%w: %s", ErrUnsupportedTool, tool)
+	}
+	example := c.Support.Example()
+	example.Tool = tool
+	conv, err := ConvertDetailed(example)
+	if err != nil {
+		return nil, nil, err
+	}
+	return example, conv, nil
+}
+
+func init() {
+	Register(Converter{
+		Tool:    detector.ToolSemanticRelease,
+		Convert: convertSemanticRelease,
+		Support: Support{
+			Converted: []string{"package.json name", "tagFormat", "branches", "plugins (github, gitlab, npm)", "commit-analyzer/release-notes-generator preset, presetConfig.types, releaseRules (also top-level)", "@semantic-release/exec commands", "top-level assets (legacy)"},
+			Manual:    []string{"@semantic-release/exec options", "unknown plugins", "JavaScript config files", "extends (shareable configs are not resolved)"},
+			Example:   semanticReleaseExample,
+		},
+	})
+	Register(Converter{
+		Tool:    detector.ToolReleaseIt,
+		Convert: convertReleaseIt,
+		Support: Support{
+			Converted: []string{"package.json name", "git.tagName", "git.commitMessage", "git.tagAnnotation", "git.requireCleanWorkingDir", "git.push", "git.changelog", "git.tagExclude (as a note)", "increment", "preReleaseId", "github.release", "github.draft", "github.preRelease", "github.assets", "gitlab.release", "npm.publish", "npm.publishPath", "npm.versionArgs (as a note)", "plugins.@release-it/bumper (in, out)", "plugins.@release-it-plugins/lerna-changelog (infile, launchEditor, labels)", "hooks (commands and command lists)"},
+			Manual:    []string{"plugin-scoped hooks (run at the lifecycle phase)", "JavaScript config files"},
+			Example:   releaseItExample,
+		},
+	})
+	Register(Converter{
+		Tool:    detector.ToolStandardVersion,
+		Convert: convertStandardVersion,
+		Support: Support{
+			Converted: []string{"package.json name", "tagPrefix", "skip.changelog", "skip.tag", "releaseCommitMessageFormat", "infile", "preset", "header", "types", "commitUrlFormat", "issueUrlFormat", "scripts.prebump (CalVer dates)"},
+			Manual:    []string{"other scripts", "JavaScript config files"},
+			Example:   standardVersionExample,
+		},
+	})
+	Register(Converter{
+		Tool:    detector.ToolGoReleaser,
+		Convert: convertGoReleaser,
+		Support: Support{
+			Converted: []string{"project_name", "release (github, gitlab, gitea)", "gitlab_urls", "gitea_urls", "changelog.skip", "changelog.disable", "changelog.use", "milestones", "archives (the first archive's format, formats, windows format_overrides and name_template arch names; files of every archive)", "release.header", "release.footer", "builds", "universal_binaries", "checksum", "kos", "dist", "snapshot", "source", "release.mode", "release.disable", "release.skip_upload", "git.tag_sort (as a note)"},
+			Manual:    []string{"other archive settings (id, ids, builds, wrap_in_directory, strip_binary_directory, meta; the formats and name_template of later archives)", "dockers", "brews", "signs", "sboms (kept as a disabled plugin)", "builds env, flags, ldflags, mod_timestamp, gomod, metadata.mod_timestamp and report_sizes (kept as a disabled plugin)", "announce"},
+			Example:   goReleaserExample,
+		},
+	})
+	Register(Converter{
+		Tool:    detector.ToolReleaseDrafter,
+		Convert: convertReleaseDrafter,
+		Support: Support{
+			Converted: []string{"tag-template", "name-template", "categories", "version-resolver"},
+			Manual:    []string{"label-driven versioning"},
+			Example:   releaseDrafterExample,
+		},
+	})
+	Register(Converter{
+		Tool:    detector.ToolChangesets,
+		Convert: convertChangesets,
+		Support: Support{
+			Converted: []string{"package.json name", "baseBranch", "changelog", "access", "pre.json (mode, tag, initialVersions)"},
+			Manual:    []string{"changeset files (bumps come from commits)", "fixed", "linked", "changelog generators"},
+			Example:   changesetsExample,
+		},
+	})
+	Register(Converter{
+		Tool:    detector.ToolMavenRelease,
+		Convert: convertMavenRelease,
+		Support: Support{
+			Converted: []string{"tagNameFormat", "pushChanges", "scm"},
+			Manual:    []string{"release profiles", "goals"},
+			Example:   mavenReleaseExample,
+		},
+	})
+}
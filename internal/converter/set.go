@@ -0,0 +1,188 @@
+package converter
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Set overrides a single field of the converted config. The path is dotted
+// using the YAML key names, e.g. "versioning.strategy" or "git.push_tags".
+// Plugins are addressed by name, e.g. "plugins.github.config.draft"; a
+// plugin that is not in the config yet is added. Values are parsed into
+// the field's type; values inside plugin config become a bool or int when
+// they look like one and a string otherwise.
+func (c *Conversion) Set(path, value string) error {
+	if path == "" {
+		return fmt.Errorf("empty config path")
+	}
+	if err := setValue(reflect.ValueOf(c.Config).Elem(), strings.Split(path, "."), value); err != nil {
+		return fmt.Errorf("cannot set %s: %w", path, err)
+	}
+	c.assume(path, setNote)
+	return nil
+}
+
+// setNote is the provenance of a field overridden with Set.
+const setNote = "set on the command line"
+
+// setValue walks v along parts and assigns value to the field it ends at.
+func setValue(v reflect.Value, parts []string, value string) error {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return setValue(v.Elem(), parts, value)
+
+	case reflect.Struct:
+		if len(parts) == 0 {
+			return fmt.Errorf("is a section, not a value")
+		}
+		field, ok := fieldByYAMLName(v, parts[0])
+		if !ok {
+			return fmt.Errorf("unknown field %q", parts[0])
+		}
+		return setValue(field, parts[1:], value)
+
+	case reflect.Map:
+		if len(parts) == 0 {
+			return fmt.Errorf("is a section, not a value")
+		}
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(v.Type()))
+		}
+		return setMapValue(v.Interface().(map[string]any), parts, value)
+
+	case reflect.Slice:
+		if v.Type() == reflect.TypeOf([]PluginConfig(nil)) {
+			return setPluginValue(v, parts, value)
+		}
+		if len(parts) != 0 {
+			return fmt.Errorf("unknown field %q", parts[0])
+		}
+		if v.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("list of %s cannot be set from the command line", v.Type().Elem().Name())
+		}
+		var items []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		v.Set(reflect.ValueOf(items))
+		return nil
+	}
+
+	if len(parts) != 0 {
+		return fmt.Errorf("unknown field %q", parts[0])
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%q is not a boolean", value)
+		}
+		v.SetBool(b)
+	case reflect.Int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", value)
+		}
+		v.SetInt(int64(n))
+	default:
+		return fmt.Errorf("unsupported field type %s", v.Type())
+	}
+	return nil
+}
+
+// setPluginValue sets a field of the plugin named parts[0], adding the
+// plugin when it is missing.
+func setPluginValue(plugins reflect.Value, parts []string, value string) error {
+	if len(parts) < 2 {
+		return fmt.Errorf("plugin fields are set as plugins.<name>.<field>")
+	}
+	list := plugins.Interface().([]PluginConfig)
+	for i := range list {
+		if list[i].Name == parts[0] {
+			return setValue(reflect.ValueOf(&list[i]).Elem(), parts[1:], value)
+		}
+	}
+
+	plugin := PluginConfig{Name: parts[0], Enabled: true}
+	if err := setValue(reflect.ValueOf(&plugin).Elem(), parts[1:], value); err != nil {
+		return err
+	}
+	plugins.Set(reflect.Append(plugins, reflect.ValueOf(plugin)))
+	return nil
+}
+
+// setMapValue sets a possibly nested key of a free-form config map.
+func setMapValue(m map[string]any, parts []string, value string) error {
+	if len(parts) == 1 {
+		m[parts[0]] = parseScalar(value)
+		return nil
+	}
+	child, ok := m[parts[0]].(map[string]any)
+	if !ok {
+		if _, exists := m[parts[0]]; exists {
+			return fmt.Errorf("%q is not a section", parts[0])
+		}
+		child = make(map[string]any)
+		m[parts[0]] = child
+	}
+	return setMapValue(child, parts[1:], value)
+}
+
+// fieldValue returns the value at a dotted YAML path of a config struct,
+// or nil when the path does not lead to a plain value.
+func fieldValue(v reflect.Value, parts []string) any {
+	for _, part := range parts {
+		if v.Kind() != reflect.Struct {
+			return nil
+		}
+		field, ok := fieldByYAMLName(v, part)
+		if !ok {
+			return nil
+		}
+		v = field
+	}
+	switch v.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int, reflect.Slice:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.String {
+			return nil
+		}
+		return v.Interface()
+	}
+	return nil
+}
+
+// fieldByYAMLName returns the struct field whose YAML key is name.
+func fieldByYAMLName(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		key, _, _ := strings.Cut(t.Field(i).Tag.Get("yaml"), ",")
+		if key == name {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// parseScalar converts a command-line value to a bool, int or string.
+func parseScalar(value string) any {
+	switch value {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if n, err := strconv.Atoi(value); err == nil {
+		return n
+	}
+	return value
+}
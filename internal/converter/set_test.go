@@ -0,0 +1,110 @@
+package converter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestConversion_Set(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		value   string
+		check   func(*RelictaConfig) any
+		want    any
+		wantErr bool
+	}{
+		{
+			name:  "string",
+			path:  "versioning.strategy",
+			value: "calver",
+			check: func(c *RelictaConfig) any { return c.Versioning.Strategy },
+			want:  "calver",
+		},
+		{
+			name:  "bool",
+			path:  "git.push_tags",
+			value: "false",
+			check: func(c *RelictaConfig) any { return c.Git.PushTags },
+			want:  false,
+		},
+		{
+			name:  "string list",
+			path:  "git.allowed_branches",
+			value: "main, release",
+			check: func(c *RelictaConfig) any { return c.Git.AllowedBranches },
+			want:  []string{"main", "release"},
+		},
+		{
+			name:  "nil pointer section",
+			path:  "ai.provider",
+			value: "openai",
+			check: func(c *RelictaConfig) any { return c.AI.Provider },
+			want:  "openai",
+		},
+		{
+			name:  "existing plugin config",
+			path:  "plugins.github.config.draft",
+			value: "true",
+			check: func(c *RelictaConfig) any { return c.Plugins[0].Config["draft"] },
+			want:  true,
+		},
+		{
+			name:  "new plugin",
+			path:  "plugins.slack.config.retries",
+			value: "3",
+			check: func(c *RelictaConfig) any { return c.Plugins[1].Config["retries"] },
+			want:  3,
+		},
+		{
+			name:    "unknown field",
+			path:    "versioning.flavour",
+			value:   "x",
+			wantErr: true,
+		},
+		{
+			name:    "section",
+			path:    "git",
+			value:   "x",
+			wantErr: true,
+		},
+		{
+			name:    "bad bool",
+			path:    "git.create_tag",
+			value:   "maybe",
+			wantErr: true,
+		},
+		{
+			name:    "struct list",
+			path:    "changelog.sections",
+			value:   "Features",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv := newConversion(&RelictaConfig{
+				Git:     GitConfig{PushTags: true},
+				Plugins: []PluginConfig{{Name: "github", Enabled: true}},
+			})
+
+			err := conv.Set(tt.path, tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Set() should return error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Set() error = %v", err)
+			}
+			if got := tt.check(conv.Config); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s = %#v, want %#v", tt.path, got, tt.want)
+			}
+			if conv.Provenance[tt.path] == "" {
+				t.Errorf("Provenance[%q] not recorded", tt.path)
+			}
+		})
+	}
+}
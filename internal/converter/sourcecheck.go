@@ -0,0 +1,162 @@
+package converter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/relicta-tech/migrate/internal/detector"
+)
+
+// sourceChecks holds the sanity checks run on each tool's source config.
+var sourceChecks = map[detector.Tool]func(data map[string]any) []Warning{
+	detector.ToolSemanticRelease: checkSemanticReleaseSource,
+	detector.ToolReleaseIt:       checkReleaseItSource,
+	detector.ToolStandardVersion: checkStandardVersionSource,
+	detector.ToolGoReleaser:      checkGoReleaserSource,
+	detector.ToolChangesets:      checkChangesetsSource,
+}
+
+// ValidateSource checks the detected source config for settings that
+// contradict each other or stop the tool from releasing at all, which the
+// conversion would otherwise carry over faithfully. Each warning names
+// the source key at fault. Tools without checks return none.
+func ValidateSource(result *detector.Result) []Warning {
+	check, ok := sourceChecks[result.Tool]
+	if !ok || result.ConfigData == nil {
+		return nil
+	}
+	return check(result.ConfigData)
+}
+
+// sourceWarning builds a warning about key in the source config.
+func sourceWarning(key, format string, args ...any) Warning {
+	return Warning{
+		Field:   key,
+		Message: "source config: " + fmt.Sprintf(format, args...),
+	}
+}
+
+// checkSemanticReleaseSource flags a dry run, which never publishes, and
+// a branches list with no branch to release from.
+func checkSemanticReleaseSource(data map[string]any) []Warning {
+	var warnings []Warning
+	if dryRun, _ := data["dryRun"].(bool); dryRun {
+		warnings = append(warnings, sourceWarning("dryRun",
+			"is true, so semantic-release never publishes, but the migrated config releases for real"))
+	}
+	if branches, ok := data["branches"].([]any); ok && len(branches) == 0 {
+		warnings = append(warnings, sourceWarning("branches", "is empty, so no branch can be released from"))
+	}
+	return warnings
+}
+
+// checkReleaseItSource flags GitHub and GitLab releases that point at a
+// tag release-it never creates or pushes, and npm options for a package
+// that is not published.
+func checkReleaseItSource(data map[string]any) []Warning {
+	var warnings []Warning
+	git, _ := data["git"].(map[string]any)
+	push, pushSet := git["push"].(bool)
+	tag, tagSet := git["tag"].(bool)
+
+	for _, forge := range []string{"github", "gitlab"} {
+		options, _ := data[forge].(map[string]any)
+		if release, _ := options["release"].(bool); !release {
+			continue
+		}
+		switch {
+		case tagSet && !tag:
+			warnings = append(warnings, sourceWarning(forge+".release",
+				"is true but git.tag is false, so the release has no tag to point at"))
+		case pushSet && !push:
+			warnings = append(warnings, sourceWarning(forge+".release",
+				"is true but git.push is false, so the release points at a tag that is never pushed"))
+		}
+	}
+
+	if npm, ok := data["npm"].(map[string]any); ok {
+		if publish, ok := npm["publish"].(bool); ok && !publish {
+			for _, key := range []string{"publishPath", "tag", "otp"} {
+				if _, set := npm[key]; set {
+					warnings = append(warnings, sourceWarning("npm."+key, "is set but npm.publish is false, so it is never used"))
+				}
+			}
+		}
+	}
+	return warnings
+}
+
+// checkStandardVersionSource flags a dry run and a config that skips
+// every step, either of which leaves nothing to release.
+func checkStandardVersionSource(data map[string]any) []Warning {
+	var warnings []Warning
+	if dryRun, _ := data["dryRun"].(bool); dryRun {
+		warnings = append(warnings, sourceWarning("dryRun", "is true, so standard-version never changes anything"))
+	}
+	skip, _ := data["skip"].(map[string]any)
+	skipped := 0
+	for _, step := range []string{"bump", "changelog", "commit", "tag"} {
+		if s, _ := skip[step].(bool); s {
+			skipped++
+		}
+	}
+	if skipped == 4 {
+		warnings = append(warnings, sourceWarning("skip", "skips bump, changelog, commit and tag, so nothing is released"))
+	}
+	return warnings
+}
+
+// checkGoReleaserSource flags release and changelog options set under a
+// section that is disabled.
+func checkGoReleaserSource(data map[string]any) []Warning {
+	var warnings []Warning
+	for _, section := range []string{"release", "changelog"} {
+		options, _ := data[section].(map[string]any)
+		if disabled, _ := options["disable"].(bool); !disabled {
+			continue
+		}
+		var set []string
+		for key := range options {
+			if key != "disable" && key != "skip_upload" {
+				set = append(set, section+"."+key)
+			}
+		}
+		if len(set) > 0 {
+			sort.Strings(set)
+			warnings = append(warnings, sourceWarning(section+".disable",
+				"is true, so these settings are ignored: %s", strings.Join(set, ", ")))
+		}
+	}
+	return warnings
+}
+
+// checkChangesetsSource flags packages that are both ignored and listed
+// in a fixed or linked group, which changesets rejects.
+func checkChangesetsSource(data map[string]any) []Warning {
+	ignored := make(map[string]bool)
+	ignore, _ := data["ignore"].([]any)
+	for _, name := range ignore {
+		if s, ok := name.(string); ok {
+			ignored[s] = true
+		}
+	}
+	if len(ignored) == 0 {
+		return nil
+	}
+
+	var warnings []Warning
+	for _, key := range []string{"fixed", "linked"} {
+		groups, _ := data[key].([]any)
+		for _, group := range groups {
+			names, _ := group.([]any)
+			for _, name := range names {
+				if s, ok := name.(string); ok && ignored[s] {
+					warnings = append(warnings, sourceWarning(key,
+						"lists %s, which is also in ignore; changesets refuses to version it", s))
+				}
+			}
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,98 @@
+package converter
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/relicta-tech/migrate/internal/detector"
+)
+
+func TestValidateSource(t *testing.T) {
+	tests := []struct {
+		name       string
+		tool       detector.Tool
+		data       map[string]any
+		wantFields []string
+	}{
+		{
+			name: "release-it release without push",
+			tool: detector.ToolReleaseIt,
+			data: map[string]any{
+				"git":    map[string]any{"push": false},
+				"github": map[string]any{"release": true},
+			},
+			wantFields: []string{"github.release"},
+		},
+		{
+			name: "release-it release without tag",
+			tool: detector.ToolReleaseIt,
+			data: map[string]any{
+				"git":    map[string]any{"tag": false, "push": false},
+				"gitlab": map[string]any{"release": true},
+			},
+			wantFields: []string{"gitlab.release"},
+		},
+		{
+			name:       "release-it npm options without publish",
+			tool:       detector.ToolReleaseIt,
+			data:       map[string]any{"npm": map[string]any{"publish": false, "publishPath": "dist"}},
+			wantFields: []string{"npm.publishPath"},
+		},
+		{
+			name: "release-it consistent",
+			tool: detector.ToolReleaseIt,
+			data: map[string]any{
+				"git":    map[string]any{"push": true},
+				"github": map[string]any{"release": true},
+			},
+		},
+		{
+			name:       "semantic-release dry run",
+			tool:       detector.ToolSemanticRelease,
+			data:       map[string]any{"dryRun": true, "branches": []any{}},
+			wantFields: []string{"dryRun", "branches"},
+		},
+		{
+			name:       "standard-version skips everything",
+			tool:       detector.ToolStandardVersion,
+			data:       map[string]any{"skip": map[string]any{"bump": true, "changelog": true, "commit": true, "tag": true}},
+			wantFields: []string{"skip"},
+		},
+		{
+			name: "goreleaser disabled release with options",
+			tool: detector.ToolGoReleaser,
+			data: map[string]any{
+				"release":   map[string]any{"disable": true, "draft": true},
+				"changelog": map[string]any{"disable": true},
+			},
+			wantFields: []string{"release.disable"},
+		},
+		{
+			name: "changesets ignored package in fixed group",
+			tool: detector.ToolChangesets,
+			data: map[string]any{
+				"ignore": []any{"docs"},
+				"fixed":  []any{[]any{"app", "docs"}},
+			},
+			wantFields: []string{"fixed"},
+		},
+		{
+			name: "tool without checks",
+			tool: detector.ToolReleaseDrafter,
+			data: map[string]any{"dryRun": true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings := ValidateSource(&detector.Result{Tool: tt.tool, ConfigData: tt.data})
+			var fields []string
+			for _, w := range warnings {
+				fields = append(fields, w.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("ValidateSource() = %v, want warnings for %v", warnings, tt.wantFields)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package converter
+
+import (
+	"regexp"
+	"strings"
+)
+
+// semverPattern matches a semantic version as defined by semver.org.
+var semverPattern = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+	`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+// prereleasePattern matches a semver prerelease identifier such as "beta".
+var prereleasePattern = regexp.MustCompile(`^[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*$`)
+
+// bumpKeywords are the increments accepted in versioning.bump besides an
+// explicit version.
+var bumpKeywords = map[string]bool{
+	"major":      true,
+	"minor":      true,
+	"patch":      true,
+	"premajor":   true,
+	"preminor":   true,
+	"prepatch":   true,
+	"prerelease": true,
+}
+
+// isSemver reports whether v is a valid semantic version. A leading "v"
+// is tolerated.
+func isSemver(v string) bool {
+	return semverPattern.MatchString(strings.TrimPrefix(v, "v"))
+}
+
+// validate checks converted values that Relicta would otherwise reject
+// at release time and records a warning for each problem.
+func validate(conv *Conversion) {
+	v := conv.Config.Versioning
+
+	if problem := tagPrefixProblem(v.TagPrefix); problem != "" {
+		conv.warn("versioning.tag_prefix", "%q %s", v.TagPrefix, problem)
+	}
+	if v.Prerelease != "" && !prereleasePattern.MatchString(v.Prerelease) {
+		conv.warn("versioning.prerelease", "%q is not a valid semver prerelease identifier", v.Prerelease)
+	}
+	if v.Bump != "" && !bumpKeywords[v.Bump] && !isSemver(v.Bump) {
+		conv.warn("versioning.bump", "%q is neither an increment keyword nor a valid semver version", v.Bump)
+	}
+
+	checkLeftoverTemplate(conv, "git.commit_message", conv.Config.Git.CommitMessage)
+	checkLeftoverTemplate(conv, "git.tag_message", conv.Config.Git.TagMessage)
+	for _, p := range conv.Config.Plugins {
+		for k, value := range p.Config {
+			if s, ok := value.(string); ok && !strings.HasPrefix(k, "_") {
+				checkLeftoverTemplate(conv, "plugins."+p.Name+"."+k, s)
+			}
+		}
+	}
+}
+
+// handlebarsVarPattern matches a bare {{name}} variable, which Relicta's
+// Go templates do not expand.
+var handlebarsVarPattern = regexp.MustCompile(`\{\{\s*[A-Za-z]\w*\s*\}\}`)
+
+// checkLeftoverTemplate warns when value still contains ${...} or {{name}}
+// syntax from the source tool that Relicta will not expand.
+func checkLeftoverTemplate(conv *Conversion, field, value string) {
+	switch {
+	case strings.Contains(value, "${"):
+		conv.warn(field, "%q contains unconverted ${...} template syntax", value)
+	case handlebarsVarPattern.MatchString(value):
+		conv.warn(field, "%q contains unconverted {{...}} template syntax", value)
+	}
+}
+
+// tagPrefixProblem describes why prefix cannot be used as a tag prefix,
+// or returns "" when it is acceptable.
+func tagPrefixProblem(prefix string) string {
+	switch {
+	case prefix == "":
+		return ""
+	case strings.Contains(prefix, "${") || strings.Contains(prefix, "{{") || strings.Contains(prefix, "}}"):
+		return "contains unconverted template syntax"
+	case strings.ContainsAny(prefix, " \t\n~^:?*[\\"):
+		return "contains characters that are not allowed in git tag names"
+	case strings.Contains(prefix, "..") || strings.Contains(prefix, "@{"):
+		return "contains a sequence that is not allowed in git tag names"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,112 @@
+package converter
+
+import (
+	"testing"
+
+	"github.com/relicta-tech/migrate/internal/detector"
+)
+
+func TestIsSemver(t *testing.T) {
+	tests := []struct {
+		version string
+		want    bool
+	}{
+		{"1.2.3", true},
+		{"v1.2.3", true},
+		{"1.0.0-beta.1", true},
+		{"1.0.0+build.5", true},
+		{"1.2", false},
+		{"01.2.3", false},
+		{"latest", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			if got := isSemver(tt.version); got != tt.want {
+				t.Errorf("isSemver(%q) = %v, want %v", tt.version, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagPrefixProblem(t *testing.T) {
+	tests := []struct {
+		prefix  string
+		wantBad bool
+	}{
+		{"", false},
+		{"v", false},
+		{"my-pkg-v", false},
+		{"@scope/pkg@", false},
+		{"v${major}.", true},
+		{"{{.Name}}-", true},
+		{"release ", true},
+		{"v:", true},
+		{"a..b", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			if got := tagPrefixProblem(tt.prefix); (got != "") != tt.wantBad {
+				t.Errorf("tagPrefixProblem(%q) = %q, wantBad %v", tt.prefix, got, tt.wantBad)
+			}
+		})
+	}
+}
+
+func TestConvertDetailed_Validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		result     *detector.Result
+		wantFields []string
+	}{
+		{
+			name: "clean config",
+			result: &detector.Result{
+				Tool:       detector.ToolReleaseIt,
+				ConfigData: map[string]any{"git": map[string]any{"tagName": "v${version}"}, "increment": "1.2.3"},
+			},
+		},
+		{
+			name: "template leftover in tag prefix",
+			result: &detector.Result{
+				Tool:       detector.ToolSemanticRelease,
+				ConfigData: map[string]any{"tagFormat": "${name}@${version}"},
+			},
+			wantFields: []string{"versioning.tag_prefix"},
+		},
+		{
+			name: "bad bump and prerelease",
+			result: &detector.Result{
+				Tool:       detector.ToolReleaseIt,
+				ConfigData: map[string]any{"increment": "1.2", "preReleaseId": "beta 1"},
+			},
+			wantFields: []string{"versioning.prerelease", "versioning.bump"},
+		},
+		{
+			name: "leftover template in commit message",
+			result: &detector.Result{
+				Tool:       detector.ToolReleaseIt,
+				ConfigData: map[string]any{"git": map[string]any{"commitMessage": "release ${version} (${latestVersion})"}},
+			},
+			wantFields: []string{"git.commit_message"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conv, err := ConvertDetailed(tt.result)
+			if err != nil {
+				t.Fatalf("ConvertDetailed() error = %v", err)
+			}
+			if len(conv.Warnings) != len(tt.wantFields) {
+				t.Fatalf("Warnings = %v, want fields %v", conv.Warnings, tt.wantFields)
+			}
+			for i, field := range tt.wantFields {
+				if conv.Warnings[i].Field != field {
+					t.Errorf("Warnings[%d].Field = %q, want %q", i, conv.Warnings[i].Field, field)
+				}
+			}
+		})
+	}
+}
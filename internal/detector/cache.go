@@ -0,0 +1,89 @@
+package detector
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Cache keeps the contents of files read and remote includes fetched
+// during one run, such as a DetectRecursive scan where a workspace root's
+// package.json is read again for every pass over it, or a CLI run that
+// detects the same directory more than once. A file entry is reused only
+// while the file's modification time and size are unchanged, so a file
+// edited mid-run is read afresh; a fetched include is kept for the life
+// of the Cache. A nil *Cache reads straight from disk and the network.
+type Cache struct {
+	mu      sync.Mutex
+	files   map[string]cachedFile
+	fetched map[string][]byte
+}
+
+// cachedFile is the contents of a file as of modTime.
+type cachedFile struct {
+	modTime time.Time
+	size    int64
+	data    []byte
+}
+
+// NewCache returns an empty Cache, to share between the detections of one
+// run through Options.Cache.
+func NewCache() *Cache {
+	return &Cache{files: make(map[string]cachedFile), fetched: make(map[string][]byte)}
+}
+
+// readFile returns the contents of path like os.ReadFile, from the cache
+// when the file has not changed since it was last read.
+func (c *Cache) readFile(path string) ([]byte, error) {
+	if c == nil {
+		return os.ReadFile(path)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	key := filepath.Clean(path)
+
+	c.mu.Lock()
+	cached, ok := c.files[key]
+	c.mu.Unlock()
+	if ok && cached.modTime.Equal(info.ModTime()) && cached.size == info.Size() {
+		return cached.data, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.files[key] = cachedFile{modTime: info.ModTime(), size: info.Size(), data: data}
+	c.mu.Unlock()
+	return data, nil
+}
+
+// fetch returns the contents of the remote config at location like the
+// package-level fetch, from the cache when it was fetched before. Failed
+// fetches are not cached.
+func (c *Cache) fetch(location string, timeout time.Duration) ([]byte, error) {
+	if c == nil {
+		return fetch(location, timeout)
+	}
+
+	c.mu.Lock()
+	data, ok := c.fetched[location]
+	c.mu.Unlock()
+	if ok {
+		return data, nil
+	}
+
+	data, err := fetch(location, timeout)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.fetched[location] = data
+	c.mu.Unlock()
+	return data, nil
+}
@@ -0,0 +1,68 @@
+package detector
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+)
+
+// changesetsDir is the directory Changesets keeps its config and pending
+// changesets in.
+const changesetsDir = ".changeset"
+
+// changesetsFiles are the Changesets config files, in lookup order.
+var changesetsFiles = []string{
+	changesetsDir + "/config.json",
+}
+
+// detectChangesets looks for a Changesets config and, when the repository
+// is in prerelease mode, the pre.json state next to it.
+func detectChangesets(d *Dir) (*Result, error) {
+	path, data, profile, err := findConfigFile(d.Path, changesetsFiles, d.Options)
+	if path == "" || err != nil {
+		return nil, err
+	}
+
+	result := newFileResult(ToolChangesets, path, data, extractChangesetsDetails(data), d.Options, profile)
+	addChangesetsPre(result, filepath.Dir(path), d.Options.Cache)
+	return result, nil
+}
+
+// addChangesetsPre reads pre.json from the .changeset directory dir and
+// stores it in Details["pre"], with its path in Details["preFile"].
+// Changesets only writes the file while in prerelease mode, so a missing
+// file is not an error; one that cannot be parsed is reported.
+func addChangesetsPre(result *Result, dir string, cache *Cache) {
+	path := filepath.Join(dir, "pre.json")
+	pre, err := readConfigFile(path, cache)
+	if errors.Is(err, fs.ErrNotExist) {
+		return
+	}
+	if err != nil {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("ignoring %s: %v", path, err))
+		return
+	}
+	result.Details["pre"] = pre
+	result.Details["preFile"] = path
+}
+
+// extractChangesetsDetails extracts key details from Changesets config.
+func extractChangesetsDetails(data map[string]any) map[string]any {
+	details := make(map[string]any)
+
+	if baseBranch, ok := data["baseBranch"].(string); ok {
+		details["baseBranch"] = baseBranch
+	}
+	if access, ok := data["access"].(string); ok {
+		details["access"] = access
+	}
+	if fixed, ok := data["fixed"].([]any); ok && len(fixed) > 0 {
+		details["fixedGroups"] = len(fixed)
+	}
+	if linked, ok := data["linked"].([]any); ok && len(linked) > 0 {
+		details["linkedGroups"] = len(linked)
+	}
+
+	return details
+}
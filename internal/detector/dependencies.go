@@ -0,0 +1,43 @@
+package detector
+
+import "fmt"
+
+// dependencyPackages lists the npm packages that install each release
+// tool that can run on its defaults alone, with no config file.
+var dependencyPackages = map[Tool][]string{
+	ToolSemanticRelease: {"semantic-release"},
+	ToolReleaseIt:       {"release-it"},
+	ToolStandardVersion: {"standard-version"},
+}
+
+// dependencyDetector returns a detector for tool listed in the
+// dependencies or devDependencies of package.json when no config was
+// found anywhere else. The project relies on the tool's defaults, which
+// are converted, and the result gets Details["inferredFromDependencies"].
+func dependencyDetector(tool Tool) func(*Dir) (*Result, error) {
+	return func(d *Dir) (*Result, error) {
+		pkg, err := d.PackageJSON()
+		if err != nil {
+			return nil, nil
+		}
+		for _, field := range []string{"devDependencies", "dependencies"} {
+			deps, _ := pkg[field].(map[string]any)
+			for _, name := range dependencyPackages[tool] {
+				if _, ok := deps[name]; !ok {
+					continue
+				}
+				result := &Result{
+					Tool:       tool,
+					ConfigFile: d.packageJSONPath(),
+					ConfigData: make(map[string]any),
+					Warnings: []string{fmt.Sprintf("no %s config found; %s is listed in %s %s, so its defaults were converted",
+						tool, name, d.packageJSONPath(), field)},
+				}
+				result.Details = extractDetails(tool, result.ConfigData)
+				result.Details["inferredFromDependencies"] = true
+				return result, nil
+			}
+		}
+		return nil, nil
+	}
+}
@@ -2,10 +2,18 @@
 package detector
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 
+	"github.com/BurntSushi/toml"
 	"gopkg.in/yaml.v3"
 )
 
@@ -19,6 +27,9 @@ const (
 	ToolReleaseIt       Tool = "release-it"
 	ToolStandardVersion Tool = "standard-version"
 	ToolGoReleaser      Tool = "goreleaser"
+	ToolReleaseDrafter  Tool = "release-drafter"
+	ToolMavenRelease    Tool = "maven-release"
+	ToolChangesets      Tool = "changesets"
 )
 
 // Result contains detection results.
@@ -27,179 +38,824 @@ type Result struct {
 	ConfigFile string
 	ConfigData map[string]any
 	Details    map[string]any
+	// Warnings lists detection problems the user should know about, such
+	// as duplicate config files.
+	Warnings []string
 }
 
+// Options controls how configuration files are located.
+type Options struct {
+	// Profile selects profile-specific files such as .goreleaser.<profile>.yml
+	// or .releaserc.<profile>.json ahead of the canonical names of every
+	// tool.
+	Profile string
+	// Strict fails detection when Profile is set but no profile-specific
+	// file exists.
+	Strict bool
+	// InputFormat forces the parser used by DetectFile ("json", "yaml" or
+	// "toml"). When empty the format is detected automatically.
+	InputFormat string
+	// Tool restricts detection to a single release tool. It also resolves
+	// package.json files that embed config for more than one tool.
+	Tool Tool
+	// Timeout bounds fetching a remote config in DetectFile. Zero means
+	// DefaultTimeout.
+	Timeout time.Duration
+	// DefaultBranch is recorded as the repository's default branch in
+	// Details["defaultBranch"]. When empty it is read from git.
+	DefaultBranch string
+	// KeepGoarch keeps GOARCH names such as amd64 in generated GoReleaser
+	// asset patterns instead of following the archives' naming. It is
+	// recorded as Details["keepGoarch"].
+	KeepGoarch bool
+	// SearchUp continues detection in parent directories when dir has no
+	// config, stopping after the root of the git work tree or at the
+	// filesystem root. The directory a config was found in is recorded as
+	// Details["dir"] when it is not dir.
+	SearchUp bool
+	// Prefer runs the detectors of these tools first, in this order, so
+	// a repository configuring several tools converts the preferred one.
+	// The other tools keep their usual order after them.
+	Prefer []Tool
+
+	// SkipRemoteIncludes leaves out GoReleaser includes that would be
+	// fetched over the network, with a warning for each, for runs that
+	// only detect or must stay offline. A DetectFile URL is still fetched.
+	SkipRemoteIncludes bool
+	// Cache, when set, shares file contents and fetched includes between
+	// the detections of one run. DetectRecursive sets one when it is nil.
+	Cache *Cache
+	// profileOnly limits findConfigFile to profile-specific files, for
+	// the pass that looks for them across every tool.
+	profileOnly bool
+}
+
+// ErrNoConfigFound is returned when no release tool configuration exists
+// where one was required, such as for a --profile in strict mode.
+var ErrNoConfigFound = errors.New("no release tool configuration found")
+
+// ErrUnsupportedTool is returned for a tool, or a config file name, that
+// no detector or converter handles.
+var ErrUnsupportedTool = errors.New("unsupported tool")
+
+// DetectionError reports a config file that could not be read or parsed.
+type DetectionError struct {
+	// Op is the failed step: "read" or "parse".
+	Op   string
+	Path string
+	Err  error
+}
+
+func (e *DetectionError) Error() string {
+	return fmt.Sprintf("failed to %s %s: %v", e.Op, e.Path, e.Err)
+}
+
+func (e *DetectionError) Unwrap() error {
+	return e.Err
+}
+
+// ConflictError reports a package.json that embeds configuration for more
+// than one release tool.
+type ConflictError struct {
+	Path string
+	Keys []string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s contains config for multiple release tools (keys: %s); use --tool to choose one",
+		e.Path, strings.Join(e.Keys, ", "))
+}
+
+// packageJSONKeys lists the package.json keys that embed release tool
+// config, in detection order.
+var packageJSONKeys = []struct {
+	key  string
+	tool Tool
+}{
+	{"release", ToolSemanticRelease},
+	{"release-it", ToolReleaseIt},
+	{"standard-version", ToolStandardVersion},
+}
+
+// Config files each tool is detected from, in lookup order.
+var (
+	semanticReleaseFiles = []string{
+		".releaserc",
+		".releaserc.json",
+		".releaserc.yaml",
+		".releaserc.yml",
+		".releaserc.json5",
+		".releaserc.js",
+		".releaserc.cjs",
+		".releaserc.mjs",
+		"release.config.js",
+		"release.config.cjs",
+		"release.config.mjs",
+	}
+	releaseItFiles = []string{
+		".release-it.json",
+		".release-it.yaml",
+		".release-it.yml",
+		".release-it.toml",
+		".release-it.js",
+		".release-it.cjs",
+		".release-it.ts",
+	}
+	standardVersionFiles = []string{
+		".versionrc",
+		".versionrc.json",
+		".versionrc.js",
+		".versionrc.cjs",
+	}
+	goReleaserFiles = []string{
+		".goreleaser.yaml",
+		".goreleaser.yml",
+		"goreleaser.yaml",
+		"goreleaser.yml",
+	}
+	releaseDrafterFiles = []string{
+		".github/release-drafter.yaml",
+		".github/release-drafter.yml",
+	}
+)
+
+// ParseTool converts a tool name to a Tool.
+func ParseTool(name string) (Tool, error) {
+	for _, tool := range Tools() {
+		if string(tool) == name {
+			return tool, nil
+		}
+	}
+	return ToolNone, fmt.Errorf("%w %q", ErrUnsupportedTool, name)
+}
+
+// Input formats accepted by Options.InputFormat.
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+	FormatTOML = "toml"
+)
+
 // Detect identifies the release tool configuration in the given directory.
 func Detect(dir string) (*Result, error) {
-	// Try each tool in order of specificity
-	detectors := []func(string) (*Result, error){
-		detectSemanticRelease,
-		detectReleaseIt,
-		detectStandardVersion,
-		detectGoReleaser,
+	return DetectWithOptions(dir, Options{})
+}
+
+// DetectContext is like Detect but stops with ctx.Err() once ctx is done.
+func DetectContext(ctx context.Context, dir string) (*Result, error) {
+	return DetectWithOptionsContext(ctx, dir, Options{})
+}
+
+// DetectWithOptions identifies the release tool configuration in the given
+// directory using the supplied options.
+func DetectWithOptions(dir string, opts Options) (*Result, error) {
+	return DetectWithOptionsContext(context.Background(), dir, opts)
+}
+
+// DetectWithOptionsContext is like DetectWithOptions but checks ctx between
+// detector attempts and stops with ctx.Err() once it is done.
+func DetectWithOptionsContext(ctx context.Context, dir string, opts Options) (*Result, error) {
+	result, err := detectDir(ctx, dir, opts)
+	if err != nil || result != nil || !opts.SearchUp {
+		return orNone(result, dir, opts, err)
 	}
 
-	for _, detect := range detectors {
-		result, err := detect(dir)
+	for current, ok := parentDir(dir); ok; current, ok = parentDir(current) {
+		result, err := detectDir(ctx, current, opts)
 		if err != nil {
-			continue // Try next detector
+			return nil, err
 		}
-		if result != nil && result.Tool != ToolNone {
+		if result != nil {
+			if result.Details == nil {
+				result.Details = make(map[string]any)
+			}
+			result.Details["dir"] = current
 			return result, nil
 		}
 	}
+	return orNone(nil, dir, opts, nil)
+}
 
+// orNone returns a detection result, or an empty one when nothing was
+// found; a missing profile config is an error in strict mode.
+func orNone(result *Result, dir string, opts Options, err error) (*Result, error) {
+	if err != nil || result != nil {
+		return result, err
+	}
+	if opts.Profile != "" && opts.Strict {
+		return nil, fmt.Errorf("%w for profile %q in %s", ErrNoConfigFound, opts.Profile, dir)
+	}
 	return &Result{Tool: ToolNone}, nil
 }
 
-// detectSemanticRelease looks for semantic-release configuration.
-func detectSemanticRelease(dir string) (*Result, error) {
-	// Check dedicated config files first
-	configFiles := []string{
-		".releaserc",
-		".releaserc.json",
-		".releaserc.yaml",
-		".releaserc.yml",
-		"release.config.js",
-		"release.config.cjs",
+// parentDir returns the directory SearchUp looks in after dir. It stops
+// after a directory holding .git, the root of the work tree.
+func parentDir(dir string) (string, bool) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", false
+	}
+	if _, err := os.Stat(filepath.Join(abs, ".git")); err == nil {
+		return "", false
 	}
+	parent := filepath.Dir(abs)
+	return parent, parent != abs
+}
 
-	for _, file := range configFiles {
-		path := filepath.Join(dir, file)
-		if data, err := readConfigFile(path); err == nil {
-			return &Result{
-				Tool:       ToolSemanticRelease,
-				ConfigFile: path,
-				ConfigData: data,
-				Details:    extractSemanticReleaseDetails(data),
-			}, nil
+// detectDir runs the detectors on dir and returns the first config found,
+// or nil when there is none. With a profile, every tool's profile-specific
+// files are tried before any canonical config, which in strict mode is
+// not tried at all.
+func detectDir(ctx context.Context, dir string, opts Options) (*Result, error) {
+	// package.json is read at most once and shared by the detectors
+	scan := NewDir(dir, opts)
+	if opts.Profile != "" {
+		scan.Options.profileOnly = true
+		result, err := runDetectors(ctx, scan, func(r *Result) bool { return r.Details["profile"] != nil })
+		scan.Options.profileOnly = false
+		if err != nil || result != nil || opts.Strict {
+			return result, err
 		}
 	}
+	return runDetectors(ctx, scan, func(*Result) bool { return true })
+}
 
-	// Check package.json for "release" key
-	pkgPath := filepath.Join(dir, "package.json")
-	if pkg, err := readPackageJSON(pkgPath); err == nil {
-		if release, ok := pkg["release"].(map[string]any); ok {
-			return &Result{
-				Tool:       ToolSemanticRelease,
-				ConfigFile: pkgPath + " (release key)",
-				ConfigData: release,
-				Details:    extractSemanticReleaseDetails(release),
-			}, nil
+// runDetectors runs the detectors on scan in order and returns the first
+// result accept takes, or nil when there is none.
+func runDetectors(ctx context.Context, scan *Dir, accept func(*Result) bool) (*Result, error) {
+	opts := scan.Options
+	for _, d := range preferred(opts.Prefer) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if opts.Tool != "" && opts.Tool != d.Tool {
+			continue
+		}
+		result, err := d.Detect(scan)
+		if isReportable(err) {
+			return nil, err
+		}
+		if err != nil {
+			continue // Try next detector
+		}
+		if result != nil && result.Tool != ToolNone && accept(result) {
+			recordOptions(result, scan.Path, opts)
+			recordPackageName(result, scan)
+			return result, nil
 		}
 	}
-
 	return nil, nil
 }
 
-// detectReleaseIt looks for release-it configuration.
-func detectReleaseIt(dir string) (*Result, error) {
-	configFiles := []string{
-		".release-it.json",
-		".release-it.yaml",
-		".release-it.yml",
-		".release-it.js",
-		".release-it.cjs",
-		".release-it.ts",
+// isReportable reports whether a detector error stops detection rather
+// than passing on to the next detector: a package.json configuring
+// several tools, or a config file that exists but is broken.
+func isReportable(err error) bool {
+	var conflict *ConflictError
+	var detection *DetectionError
+	return errors.As(err, &conflict) || errors.As(err, &detection)
+}
+
+// DetectAll returns the configuration of every release tool found in dir,
+// in detection order, rather than only the first. Each tool appears at
+// most once. It returns an empty slice when nothing is found.
+func DetectAll(dir string, opts Options) ([]*Result, error) {
+	return DetectAllContext(context.Background(), dir, opts)
+}
+
+// DetectAllContext is like DetectAll but stops with ctx.Err() once ctx is
+// done.
+func DetectAllContext(ctx context.Context, dir string, opts Options) ([]*Result, error) {
+	scan := NewDir(dir, opts)
+	found := make(map[Tool]bool)
+	var results []*Result
+	for _, d := range preferred(opts.Prefer) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if found[d.Tool] || (opts.Tool != "" && opts.Tool != d.Tool) {
+			continue
+		}
+		result, err := d.Detect(scan)
+		if isReportable(err) {
+			return nil, err
+		}
+		if err != nil || result == nil || result.Tool == ToolNone {
+			continue
+		}
+		// An installed tool without config only stands in when nothing
+		// is configured
+		if result.Details["inferredFromDependencies"] == true && len(results) > 0 {
+			continue
+		}
+		found[result.Tool] = true
+		recordOptions(result, dir, opts)
+		recordPackageName(result, scan)
+		results = append(results, result)
 	}
+	return results, nil
+}
 
-	for _, file := range configFiles {
-		path := filepath.Join(dir, file)
-		if data, err := readConfigFile(path); err == nil {
-			return &Result{
-				Tool:       ToolReleaseIt,
-				ConfigFile: path,
-				ConfigData: data,
-				Details:    extractReleaseItDetails(data),
-			}, nil
+// DetectFile reads an explicitly named config file. The path may be an
+// http(s) URL and the contents may be gzip-compressed. The tool is inferred
+// from the file name.
+func DetectFile(path string, opts Options) (*Result, error) {
+	result, err := detectFile(path, opts)
+	if err != nil || result.Tool == ToolNone {
+		return result, err
+	}
+	dir := ""
+	if !isURL(path) {
+		dir = filepath.Dir(path)
+	}
+	recordOptions(result, dir, opts)
+	if dir != "" {
+		recordPackageName(result, NewDir(dir, opts))
+	}
+	return result, nil
+}
+
+// detectFile reads and parses the file for DetectFile.
+func detectFile(path string, opts Options) (*Result, error) {
+	if !isURL(path) && filepath.Base(path) == "package.json" {
+		return detectPackageJSONFile(path, opts)
+	}
+
+	tool := opts.Tool
+	if tool == "" {
+		tool = ToolFromFilename(sourceName(path))
+	}
+	if tool == ToolNone {
+		return nil, fmt.Errorf("%w: cannot determine release tool from file name %s", ErrUnsupportedTool, path)
+	}
+
+	raw, err := readSource(path, opts)
+	if err != nil {
+		return nil, &DetectionError{Op: "read", Path: path, Err: err}
+	}
+	var data map[string]any
+	if tool == ToolMavenRelease {
+		data, err = parsePOM(raw)
+		if err == nil && data == nil {
+			err = fmt.Errorf("no %s configured", mavenReleasePlugin)
 		}
+	} else {
+		data, err = parseConfig(sourceName(path), raw, opts.InputFormat)
+	}
+	if err != nil {
+		return nil, &DetectionError{Op: "parse", Path: path, Err: err}
 	}
 
-	// Check package.json for "release-it" key
-	pkgPath := filepath.Join(dir, "package.json")
-	if pkg, err := readPackageJSON(pkgPath); err == nil {
-		if releaseIt, ok := pkg["release-it"].(map[string]any); ok {
-			return &Result{
-				Tool:       ToolReleaseIt,
-				ConfigFile: pkgPath + " (release-it key)",
-				ConfigData: releaseIt,
-				Details:    extractReleaseItDetails(releaseIt),
-			}, nil
+	var included, warnings []string
+	if tool == ToolGoReleaser {
+		data, included, warnings = resolveGoReleaserIncludes(path, data, opts)
+	}
+	result := &Result{
+		Tool:       tool,
+		ConfigFile: path,
+		ConfigData: data,
+		Details:    extractDetails(tool, data),
+	}
+	addIncludes(result, included, warnings)
+	if tool == ToolChangesets && !isURL(path) {
+		addChangesetsPre(result, filepath.Dir(path), opts.Cache)
+	}
+	if tool == ToolReleaseIt && !isURL(path) {
+		addLernaChangelogLabels(result, filepath.Dir(path), opts.Cache)
+	}
+	return result, nil
+}
+
+// addIncludes records the files merged into a config in
+// Details["includes"], along with any include warnings.
+func addIncludes(result *Result, included, warnings []string) {
+	if len(included) > 0 {
+		result.Details["includes"] = included
+	}
+	result.Warnings = append(result.Warnings, warnings...)
+}
+
+// ToolFromFilename infers the release tool from a config file name.
+// A leading service name is allowed for dotfile names, so
+// api.releaserc.json and api.goreleaser.yaml are recognized too. It
+// returns ToolNone when the name is not recognized.
+func ToolFromFilename(path string) Tool {
+	base := filepath.Base(path)
+	if base == "config.json" && filepath.Base(filepath.Dir(path)) == changesetsDir {
+		return ToolChangesets
+	}
+	if tool := toolFromBase(base); tool != ToolNone {
+		return tool
+	}
+	if _, rest, ok := strings.Cut(base, "."); ok && rest != "" {
+		return toolFromBase("." + rest)
+	}
+	return ToolNone
+}
+
+// toolFromBase matches a base file name against the known config names.
+func toolFromBase(base string) Tool {
+	switch {
+	case base == ".releaserc" || strings.HasPrefix(base, ".releaserc.") || strings.HasPrefix(base, "release.config."):
+		return ToolSemanticRelease
+	case strings.HasPrefix(base, ".release-it."):
+		return ToolReleaseIt
+	case base == ".versionrc" || strings.HasPrefix(base, ".versionrc."):
+		return ToolStandardVersion
+	case strings.HasPrefix(base, ".goreleaser.") || strings.HasPrefix(base, "goreleaser."):
+		return ToolGoReleaser
+	case strings.HasPrefix(base, "release-drafter."):
+		return ToolReleaseDrafter
+	case base == "pom.xml":
+		return ToolMavenRelease
+	default:
+		return ToolNone
+	}
+}
+
+// detectPackageJSONFile detects release config embedded in a package.json.
+func detectPackageJSONFile(path string, opts Options) (*Result, error) {
+	pkg, err := readPackageJSON(path, opts.Cache)
+	if err != nil {
+		return nil, &DetectionError{Op: "read", Path: path, Err: err}
+	}
+
+	for _, k := range packageJSONKeys {
+		if opts.Tool != "" && opts.Tool != k.tool {
+			continue
+		}
+		if result, err := packageJSONResult(path, pkg, k.tool, opts); result != nil || err != nil {
+			if result != nil && result.Tool == ToolReleaseIt {
+				addLernaChangelogLabels(result, filepath.Dir(path), opts.Cache)
+			}
+			return result, err
 		}
 	}
 
-	return nil, nil
+	return &Result{Tool: ToolNone}, nil
 }
 
-// detectStandardVersion looks for standard-version configuration.
-func detectStandardVersion(dir string) (*Result, error) {
-	configFiles := []string{
-		".versionrc",
-		".versionrc.json",
-		".versionrc.js",
-		".versionrc.cjs",
+// detectPackageJSON looks for tool config embedded in the directory's
+// package.json.
+func detectPackageJSON(d *Dir, tool Tool) (*Result, error) {
+	pkg, err := d.PackageJSON()
+	if err != nil {
+		return nil, nil
 	}
+	return packageJSONResult(d.packageJSONPath(), pkg, tool, d.Options)
+}
 
-	for _, file := range configFiles {
-		path := filepath.Join(dir, file)
-		if data, err := readConfigFile(path); err == nil {
-			return &Result{
-				Tool:       ToolStandardVersion,
-				ConfigFile: path,
-				ConfigData: data,
-				Details:    extractStandardVersionDetails(data),
-			}, nil
+// packageJSONResult builds a Result from the key tool uses in a parsed
+// package.json. It returns a ConflictError when other release tools are
+// configured in the same file and opts.Tool does not pick one.
+func packageJSONResult(path string, pkg map[string]any, tool Tool, opts Options) (*Result, error) {
+	var key string
+	var present []string
+	for _, k := range packageJSONKeys {
+		if _, ok := pkg[k.key].(map[string]any); ok {
+			present = append(present, k.key)
+		}
+		if k.tool == tool {
+			key = k.key
 		}
 	}
 
-	// Check package.json for "standard-version" key
-	pkgPath := filepath.Join(dir, "package.json")
-	if pkg, err := readPackageJSON(pkgPath); err == nil {
-		if sv, ok := pkg["standard-version"].(map[string]any); ok {
-			return &Result{
-				Tool:       ToolStandardVersion,
-				ConfigFile: pkgPath + " (standard-version key)",
-				ConfigData: sv,
-				Details:    extractStandardVersionDetails(sv),
-			}, nil
+	data, ok := pkg[key].(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+	if len(present) > 1 && opts.Tool == "" {
+		return nil, &ConflictError{Path: path, Keys: present}
+	}
+
+	return &Result{
+		Tool:       tool,
+		ConfigFile: path + " (" + key + " key)",
+		ConfigData: data,
+		Details:    extractDetails(tool, data),
+	}, nil
+}
+
+// PackageJSONSource returns the package.json file and key a result was
+// read from, or ok false when the config did not come from package.json.
+func PackageJSONSource(result *Result) (path, key string, ok bool) {
+	for _, k := range packageJSONKeys {
+		if path, ok := strings.CutSuffix(result.ConfigFile, " ("+k.key+" key)"); ok {
+			return path, k.key, true
 		}
 	}
+	return "", "", false
+}
 
-	return nil, nil
+// extractDetails extracts key details for the given tool.
+func extractDetails(tool Tool, data map[string]any) map[string]any {
+	for _, d := range Detectors() {
+		if d.Tool == tool && d.Details != nil {
+			return d.Details(data)
+		}
+	}
+	return make(map[string]any)
+}
+
+// recordOptions stores the options converters need in the result's
+// details: keepGoarch when set, and the repository's default branch,
+// which is opts.DefaultBranch or the branch read from the git repository
+// containing dir when that is known.
+func recordOptions(result *Result, dir string, opts Options) {
+	if opts.KeepGoarch {
+		if result.Details == nil {
+			result.Details = make(map[string]any)
+		}
+		result.Details["keepGoarch"] = true
+	}
+	branch := opts.DefaultBranch
+	if branch == "" && dir != "" {
+		branch = DefaultBranch(dir)
+	}
+	if branch == "" {
+		return
+	}
+	if result.Details == nil {
+		result.Details = make(map[string]any)
+	}
+	result.Details["defaultBranch"] = branch
+}
+
+// recordPackageName stores the name from the package.json next to a
+// JavaScript tool's config as Details["packageName"].
+func recordPackageName(result *Result, d *Dir) {
+	switch result.Tool {
+	case ToolSemanticRelease, ToolReleaseIt, ToolStandardVersion, ToolChangesets:
+	default:
+		return
+	}
+	pkg, err := d.PackageJSON()
+	if err != nil {
+		return
+	}
+	if name, ok := pkg["name"].(string); ok && name != "" {
+		if result.Details == nil {
+			result.Details = make(map[string]any)
+		}
+		result.Details["packageName"] = name
+	}
+}
+
+// findConfigFile returns the first existing config file from files in
+// dir. Profile-specific variants are tried before the canonical names. A
+// file that exists but cannot be read or parsed is a DetectionError.
+func findConfigFile(dir string, files []string, opts Options) (path string, data map[string]any, profile bool, err error) {
+	if opts.Profile != "" {
+		for _, file := range files {
+			path := filepath.Join(dir, profileFileName(file, opts.Profile))
+			if data, err := readConfig(path, opts.Cache); data != nil || err != nil {
+				return path, data, true, err
+			}
+		}
+	}
+	if opts.profileOnly {
+		return "", nil, false, nil
+	}
+
+	for _, file := range files {
+		path := filepath.Join(dir, file)
+		if data, err := readConfig(path, opts.Cache); data != nil || err != nil {
+			return path, data, false, err
+		}
+	}
+
+	return "", nil, false, nil
+}
+
+// readConfig reads the config file at path for a detector. It returns
+// nil and no error when the file does not exist, and a DetectionError
+// when it cannot be read or parsed. An empty file is read as an empty
+// config.
+func readConfig(path string, cache *Cache) (map[string]any, error) {
+	raw, err := cache.readFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, &DetectionError{Op: "read", Path: path, Err: err}
+	}
+	data, err := parseConfig(path, raw, "")
+	if err != nil {
+		return nil, &DetectionError{Op: "parse", Path: path, Err: err}
+	}
+	if data == nil {
+		data = make(map[string]any)
+	}
+	return data, nil
+}
+
+// profileFileName inserts profile before the file extension, e.g.
+// ".goreleaser.yml" becomes ".goreleaser.pro.yml" and ".releaserc"
+// becomes ".releaserc.pro".
+func profileFileName(file, profile string) string {
+	ext := filepath.Ext(file)
+	base := strings.TrimSuffix(file, ext)
+	if base == "" {
+		return file + "." + profile
+	}
+	return base + "." + profile + ext
+}
+
+// newFileResult builds a Result for a config file found by findConfigFile.
+func newFileResult(tool Tool, path string, data map[string]any, details map[string]any, opts Options, profile bool) *Result {
+	if profile {
+		details["profile"] = opts.Profile
+	}
+	result := &Result{
+		Tool:       tool,
+		ConfigFile: path,
+		ConfigData: data,
+		Details:    details,
+	}
+	if dup := yamlSibling(path); dup != "" {
+		result.Warnings = append(result.Warnings,
+			fmt.Sprintf("duplicate config files found: using %s and ignoring %s", path, dup))
+	}
+	return result
+}
+
+// yamlSibling returns the .yml/.yaml counterpart of path if it exists.
+// Detectors always prefer .yaml over .yml when both are present.
+func yamlSibling(path string) string {
+	var sibling string
+	switch filepath.Ext(path) {
+	case ".yaml":
+		sibling = strings.TrimSuffix(path, ".yaml") + ".yml"
+	case ".yml":
+		sibling = strings.TrimSuffix(path, ".yml") + ".yaml"
+	default:
+		return ""
+	}
+	if _, err := os.Stat(sibling); err != nil {
+		return ""
+	}
+	return sibling
+}
+
+// detectSemanticRelease looks for semantic-release configuration.
+func detectSemanticRelease(d *Dir) (*Result, error) {
+	// Check dedicated config files first
+	path, data, profile, err := findConfigFile(d.Path, semanticReleaseFiles, d.Options)
+	if err != nil {
+		return nil, err
+	}
+	if path != "" {
+		return newFileResult(ToolSemanticRelease, path, data, extractSemanticReleaseDetails(data), d.Options, profile), nil
+	}
+
+	// Check package.json for "release" key
+	return detectPackageJSON(d, ToolSemanticRelease)
+}
+
+// detectReleaseIt looks for release-it configuration.
+func detectReleaseIt(d *Dir) (*Result, error) {
+	path, data, profile, err := findConfigFile(d.Path, releaseItFiles, d.Options)
+	if err != nil {
+		return nil, err
+	}
+	if path != "" {
+		result := newFileResult(ToolReleaseIt, path, data, extractReleaseItDetails(data), d.Options, profile)
+		addLernaChangelogLabels(result, d.Path, d.Options.Cache)
+		return result, nil
+	}
+
+	// Check package.json for "release-it" key
+	result, err := detectPackageJSON(d, ToolReleaseIt)
+	if result != nil {
+		addLernaChangelogLabels(result, d.Path, d.Options.Cache)
+	}
+	return result, err
+}
+
+// detectStandardVersion looks for standard-version configuration.
+func detectStandardVersion(d *Dir) (*Result, error) {
+	path, data, profile, err := findConfigFile(d.Path, standardVersionFiles, d.Options)
+	if err != nil {
+		return nil, err
+	}
+	if path != "" {
+		return newFileResult(ToolStandardVersion, path, data, extractStandardVersionDetails(data), d.Options, profile), nil
+	}
+
+	// Check package.json for "standard-version" key
+	return detectPackageJSON(d, ToolStandardVersion)
 }
 
-// readConfigFile reads JSON or YAML config files.
-func readConfigFile(path string) (map[string]any, error) {
-	data, err := os.ReadFile(path)
+// readConfigFile reads JSON, YAML or TOML config files, through cache
+// when it is not nil.
+func readConfigFile(path string, cache *Cache) (map[string]any, error) {
+	data, err := cache.readFile(path)
 	if err != nil {
 		return nil, err
 	}
+	return parseConfig(path, data, "")
+}
+
+// ReadConfigFile reads a JSON, YAML or TOML file the way release tool
+// configs are read, for settings files of migrate itself.
+func ReadConfigFile(path string) (map[string]any, error) {
+	return readConfigFile(path, nil)
+}
+
+// parseConfig parses config file contents. When format is empty the format
+// is guessed from the file name and by trying JSON, JSON5 (comments and
+// trailing commas) then YAML; otherwise the given parser is used. Contents
+// that no parser accepts are reported with the error of the parser the
+// file name calls for, or of both JSON and YAML when it calls for none.
+func parseConfig(name string, data []byte, format string) (map[string]any, error) {
+	if format != "" {
+		return parseConfigAs(data, format)
+	}
 
 	var result map[string]any
+	ext := filepath.Ext(name)
+
+	// TOML is only attempted for .toml files since most YAML is not TOML
+	if ext == ".toml" {
+		if err := toml.Unmarshal(data, &result); err != nil {
+			return nil, fmt.Errorf("invalid TOML: %w", err)
+		}
+		return result, nil
+	}
+
+	// JS/TS files are read when they export a static object literal;
+	// otherwise a stub marks that the file exists and why it could not
+	// be read
+	if jsConfigExts[ext] {
+		result, err := parseJSConfig(data)
+		if err == nil {
+			return result, nil
+		}
+		return map[string]any{"_jsConfig": true, "_jsError": err.Error()}, nil
+	}
+
+	if ext == ".json5" {
+		result, err := parseJSON5(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON5: %w", err)
+		}
+		return result, nil
+	}
 
 	// Try JSON first
-	if err := json.Unmarshal(data, &result); err == nil {
+	jsonErr := json.Unmarshal(data, &result)
+	if jsonErr == nil {
 		return result, nil
 	}
 
-	// Try YAML
-	if err := yaml.Unmarshal(data, &result); err == nil {
+	// Then JSON5, for JSON files with comments or trailing commas
+	if result, err := parseJSON5(data); err == nil {
 		return result, nil
 	}
 
-	// For JS/TS files, we can't parse them directly
-	// Return empty map to indicate file exists
-	ext := filepath.Ext(path)
-	if ext == ".js" || ext == ".cjs" || ext == ".ts" {
-		return map[string]any{"_jsConfig": true}, nil
+	// Try YAML, merging multi-document streams
+	result, yamlErr := parseYAML(data)
+	if yamlErr == nil {
+		return result, nil
+	}
+
+	switch ext {
+	case ".json":
+		return nil, fmt.Errorf("invalid JSON: %w", jsonErr)
+	case ".yaml", ".yml":
+		return nil, fmt.Errorf("invalid YAML: %w", yamlErr)
+	}
+	return nil, fmt.Errorf("neither JSON (%w) nor YAML (%w)", jsonErr, yamlErr)
+}
+
+// parseConfigAs parses config file contents with the given format.
+func parseConfigAs(data []byte, format string) (map[string]any, error) {
+	var result map[string]any
+	var err error
+	switch format {
+	case FormatJSON:
+		err = json.Unmarshal(data, &result)
+	case FormatYAML:
+		result, err = parseYAML(data)
+	case FormatTOML:
+		err = toml.Unmarshal(data, &result)
+	default:
+		return nil, fmt.Errorf("unsupported input format %q (want json, yaml or toml)", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", format, err)
+	}
+	if result == nil {
+		result = make(map[string]any)
 	}
 
-	return nil, os.ErrNotExist
+	return result, nil
 }
 
-// readPackageJSON reads and parses package.json.
-func readPackageJSON(path string) (map[string]any, error) {
-	data, err := os.ReadFile(path)
+// readPackageJSON reads and parses package.json, through cache when it is
+// not nil.
+func readPackageJSON(path string, cache *Cache) (map[string]any, error) {
+	data, err := cache.readFile(path)
 	if err != nil {
 		return nil, err
 	}
@@ -225,10 +881,27 @@ func extractSemanticReleaseDetails(data map[string]any) map[string]any {
 	if tagFormat, ok := data["tagFormat"]; ok {
 		details["tagFormat"] = tagFormat
 	}
+	if extends := semanticReleaseExtends(data); len(extends) > 0 {
+		details["extends"] = extends
+	}
 
 	return details
 }
 
+// semanticReleaseExtends returns the shareable configs a semantic-release
+// config extends, given as a single name or a list.
+func semanticReleaseExtends(data map[string]any) []string {
+	switch extends := data["extends"].(type) {
+	case string:
+		if extends = strings.TrimSpace(extends); extends != "" {
+			return []string{extends}
+		}
+	case []any:
+		return toStrings(extends)
+	}
+	return nil
+}
+
 // extractReleaseItDetails extracts key details from release-it config.
 func extractReleaseItDetails(data map[string]any) map[string]any {
 	details := make(map[string]any)
@@ -283,24 +956,16 @@ func countPlugins(plugins any) int {
 }
 
 // detectGoReleaser looks for GoReleaser configuration.
-func detectGoReleaser(dir string) (*Result, error) {
-	configFiles := []string{
-		".goreleaser.yml",
-		".goreleaser.yaml",
-		"goreleaser.yml",
-		"goreleaser.yaml",
+func detectGoReleaser(d *Dir) (*Result, error) {
+	path, data, profile, err := findConfigFile(d.Path, goReleaserFiles, d.Options)
+	if err != nil {
+		return nil, err
 	}
-
-	for _, file := range configFiles {
-		path := filepath.Join(dir, file)
-		if data, err := readConfigFile(path); err == nil {
-			return &Result{
-				Tool:       ToolGoReleaser,
-				ConfigFile: path,
-				ConfigData: data,
-				Details:    extractGoReleaserDetails(data),
-			}, nil
-		}
+	if path != "" {
+		data, included, warnings := resolveGoReleaserIncludes(path, data, d.Options)
+		result := newFileResult(ToolGoReleaser, path, data, extractGoReleaserDetails(data), d.Options, profile)
+		addIncludes(result, included, warnings)
+		return result, nil
 	}
 
 	return nil, nil
@@ -362,3 +1027,150 @@ func extractGoReleaserDetails(data map[string]any) map[string]any {
 
 	return details
 }
+
+// detectReleaseDrafter looks for release-drafter GitHub Action configuration.
+func detectReleaseDrafter(d *Dir) (*Result, error) {
+	path, data, profile, err := findConfigFile(d.Path, releaseDrafterFiles, d.Options)
+	if err != nil {
+		return nil, err
+	}
+	if path != "" {
+		return newFileResult(ToolReleaseDrafter, path, data, extractReleaseDrafterDetails(data), d.Options, profile), nil
+	}
+
+	return nil, nil
+}
+
+// extractReleaseDrafterDetails extracts key details from release-drafter config.
+func extractReleaseDrafterDetails(data map[string]any) map[string]any {
+	details := make(map[string]any)
+
+	if nameTemplate, ok := data["name-template"].(string); ok {
+		details["nameTemplate"] = nameTemplate
+	}
+	if tagTemplate, ok := data["tag-template"].(string); ok {
+		details["tagTemplate"] = tagTemplate
+	}
+	if categories, ok := data["categories"].([]any); ok {
+		details["categoriesCount"] = len(categories)
+	}
+	if _, ok := data["version-resolver"].(map[string]any); ok {
+		details["versionResolver"] = true
+	}
+
+	return details
+}
+
+// semanticReleaseAction is the GitHub Action that runs semantic-release
+// with its configuration supplied as step inputs.
+const semanticReleaseAction = "cycjimmy/semantic-release-action"
+
+// detectGitHubActions looks for semantic-release configured inline in a
+// GitHub Actions workflow.
+func detectGitHubActions(d *Dir) (*Result, error) {
+	var workflows []string
+	for _, pattern := range []string{"*.yml", "*.yaml"} {
+		matches, err := filepath.Glob(filepath.Join(d.Path, ".github", "workflows", pattern))
+		if err != nil {
+			return nil, err
+		}
+		workflows = append(workflows, matches...)
+	}
+
+	for _, path := range workflows {
+		workflow, err := readConfigFile(path, d.Options.Cache)
+		if err != nil {
+			continue
+		}
+		if inputs, ok := findActionInputs(workflow, semanticReleaseAction); ok {
+			data := semanticReleaseFromActionInputs(inputs)
+			details := extractSemanticReleaseDetails(data)
+			details["detectedVia"] = "github-actions"
+			return &Result{
+				Tool:       ToolSemanticRelease,
+				ConfigFile: path + " (" + semanticReleaseAction + ")",
+				ConfigData: data,
+				Details:    details,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// findActionInputs returns the with: inputs of the first workflow step
+// that uses the given action.
+func findActionInputs(workflow map[string]any, action string) (map[string]any, bool) {
+	jobs, _ := workflow["jobs"].(map[string]any)
+	names := make([]string, 0, len(jobs))
+	for name := range jobs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		job, _ := jobs[name].(map[string]any)
+		steps, _ := job["steps"].([]any)
+		for _, st := range steps {
+			step, _ := st.(map[string]any)
+			uses, _ := step["uses"].(string)
+			if uses != action && !strings.HasPrefix(uses, action+"@") {
+				continue
+			}
+			inputs, _ := step["with"].(map[string]any)
+			if inputs == nil {
+				inputs = make(map[string]any)
+			}
+			return inputs, true
+		}
+	}
+	return nil, false
+}
+
+// semanticReleaseFromActionInputs builds semantic-release shaped config
+// data from cycjimmy/semantic-release-action inputs.
+func semanticReleaseFromActionInputs(inputs map[string]any) map[string]any {
+	data := make(map[string]any)
+
+	if branches, ok := inputs["branches"].(string); ok {
+		var parsed []any
+		if err := yaml.Unmarshal([]byte(branches), &parsed); err == nil && len(parsed) > 0 {
+			data["branches"] = parsed
+		} else if name := strings.TrimSpace(branches); name != "" {
+			data["branches"] = []any{name}
+		}
+	} else if branch, ok := inputs["branch"].(string); ok {
+		data["branches"] = []any{branch}
+	}
+
+	if extra, ok := inputs["extra_plugins"].(string); ok {
+		var plugins []any
+		for _, pkg := range strings.Fields(extra) {
+			plugins = append(plugins, stripPackageVersion(pkg))
+		}
+		if len(plugins) > 0 {
+			data["plugins"] = plugins
+		}
+	}
+
+	if tagFormat, ok := inputs["tag_format"].(string); ok {
+		data["tagFormat"] = tagFormat
+	}
+	if extends, ok := inputs["extends"].(string); ok {
+		data["extends"] = strings.TrimSpace(extends)
+	}
+	if dryRun, ok := inputs["dry_run"]; ok {
+		data["dryRun"] = dryRun
+	}
+
+	return data
+}
+
+// stripPackageVersion removes an npm version suffix, e.g.
+// "@semantic-release/git@10.0.1" -> "@semantic-release/git".
+func stripPackageVersion(pkg string) string {
+	if i := strings.LastIndex(pkg, "@"); i > 0 {
+		return pkg[:i]
+	}
+	return pkg
+}
@@ -1,9 +1,19 @@
 package detector
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestDetect_SemanticRelease(t *testing.T) {
@@ -89,6 +99,14 @@ func TestDetect_ReleaseIt(t *testing.T) {
 			wantTool:   ToolReleaseIt,
 			wantConfig: ".release-it.yaml",
 		},
+		{
+			name: "release-it.toml",
+			files: map[string]string{
+				".release-it.toml": "[git]\ntagName = \"v${version}\"\n\n[github]\nrelease = true",
+			},
+			wantTool:   ToolReleaseIt,
+			wantConfig: ".release-it.toml",
+		},
 		{
 			name: "package.json with release-it key",
 			files: map[string]string{
@@ -270,6 +288,149 @@ changelog:
 	}
 }
 
+func TestDetect_ReleaseDrafter(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := os.MkdirAll(filepath.Join(dir, ".github"), 0755); err != nil {
+		t.Fatalf("failed to create .github: %v", err)
+	}
+	configContent := `name-template: 'v$RESOLVED_VERSION'
+tag-template: 'v$RESOLVED_VERSION'
+categories:
+  - title: Features
+    labels: [feature]
+version-resolver:
+  minor:
+    labels: [feature]
+  default: patch`
+
+	path := filepath.Join(dir, ".github", "release-drafter.yml")
+	if err := os.WriteFile(path, []byte(configContent), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	result, err := Detect(dir)
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+
+	if result.Tool != ToolReleaseDrafter {
+		t.Errorf("Detect() tool = %v, want %v", result.Tool, ToolReleaseDrafter)
+	}
+	if result.Details["tagTemplate"] != "v$RESOLVED_VERSION" {
+		t.Errorf("tagTemplate = %v, want v$RESOLVED_VERSION", result.Details["tagTemplate"])
+	}
+	if result.Details["categoriesCount"] != 1 {
+		t.Errorf("categoriesCount = %v, want 1", result.Details["categoriesCount"])
+	}
+}
+
+func TestDetect_GitHubActions(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := os.MkdirAll(filepath.Join(dir, ".github", "workflows"), 0755); err != nil {
+		t.Fatalf("failed to create workflows dir: %v", err)
+	}
+	workflow := `name: Release
+on:
+  push:
+    branches: [main]
+jobs:
+  release:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+      - uses: cycjimmy/semantic-release-action@v4
+        with:
+          branches: |
+            [
+              'main',
+              {name: 'beta', prerelease: true}
+            ]
+          extra_plugins: |
+            @semantic-release/changelog@6.0.0
+            @semantic-release/git
+          tag_format: v${version}`
+
+	path := filepath.Join(dir, ".github", "workflows", "release.yml")
+	if err := os.WriteFile(path, []byte(workflow), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	result, err := Detect(dir)
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+
+	if result.Tool != ToolSemanticRelease {
+		t.Fatalf("Detect() tool = %v, want %v", result.Tool, ToolSemanticRelease)
+	}
+	if result.Details["detectedVia"] != "github-actions" {
+		t.Errorf("detectedVia = %v, want github-actions", result.Details["detectedVia"])
+	}
+
+	branches, _ := result.ConfigData["branches"].([]any)
+	if len(branches) != 2 || branches[0] != "main" {
+		t.Errorf("branches = %v, want [main {beta}]", branches)
+	}
+
+	plugins, _ := result.ConfigData["plugins"].([]any)
+	if len(plugins) != 2 || plugins[0] != "@semantic-release/changelog" || plugins[1] != "@semantic-release/git" {
+		t.Errorf("plugins = %v, want changelog and git without versions", plugins)
+	}
+
+	if result.ConfigData["tagFormat"] != "v${version}" {
+		t.Errorf("tagFormat = %v, want v${version}", result.ConfigData["tagFormat"])
+	}
+}
+
+func TestDetect_DuplicateYAMLFiles(t *testing.T) {
+	tests := []struct {
+		name       string
+		files      []string
+		wantConfig string
+	}{
+		{
+			name:       "semantic-release prefers yaml",
+			files:      []string{".releaserc.yml", ".releaserc.yaml"},
+			wantConfig: ".releaserc.yaml",
+		},
+		{
+			name:       "goreleaser prefers yaml",
+			files:      []string{".goreleaser.yml", ".goreleaser.yaml"},
+			wantConfig: ".goreleaser.yaml",
+		},
+		{
+			name:       "release-it prefers yaml",
+			files:      []string{".release-it.yml", ".release-it.yaml"},
+			wantConfig: ".release-it.yaml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for _, filename := range tt.files {
+				if err := os.WriteFile(filepath.Join(dir, filename), []byte("branches:\n  - main"), 0644); err != nil {
+					t.Fatalf("failed to write test file: %v", err)
+				}
+			}
+
+			result, err := Detect(dir)
+			if err != nil {
+				t.Fatalf("Detect() error = %v", err)
+			}
+
+			if filepath.Base(result.ConfigFile) != tt.wantConfig {
+				t.Errorf("Detect() configFile = %v, want %v", result.ConfigFile, tt.wantConfig)
+			}
+			if len(result.Warnings) != 1 {
+				t.Errorf("Detect() warnings = %v, want one duplicate warning", result.Warnings)
+			}
+		})
+	}
+}
+
 func TestDetect_GoReleaser_ConfigData(t *testing.T) {
 	dir := t.TempDir()
 
@@ -343,9 +504,9 @@ func TestDetect_Priority(t *testing.T) {
 	dir := t.TempDir()
 
 	files := map[string]string{
-		".releaserc.json":   `{"branches": ["main"]}`,
-		".release-it.json":  `{"git": {"tagName": "v${version}"}}`,
-		".versionrc.json":   `{"tagPrefix": "v"}`,
+		".releaserc.json":  `{"branches": ["main"]}`,
+		".release-it.json": `{"git": {"tagName": "v${version}"}}`,
+		".versionrc.json":  `{"tagPrefix": "v"}`,
 	}
 
 	for filename, content := range files {
@@ -365,7 +526,1353 @@ func TestDetect_Priority(t *testing.T) {
 	}
 }
 
-func contains(s, substr string) bool {
-	return filepath.Base(s) == substr || s == substr ||
-		(len(s) > len(substr) && s[len(s)-len(substr):] == substr)
+func TestDetect_PackageJSONConflict(t *testing.T) {
+	dir := t.TempDir()
+
+	content := `{"name": "test", "release": {"branches": ["main"]}, "release-it": {"git": {"tagName": "v${version}"}}}`
+	if err := os.WriteFile(filepath.Join(dir, "package.json"), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	_, err := Detect(dir)
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("Detect() error = %v, want ConflictError", err)
+	}
+	if len(conflict.Keys) != 2 || conflict.Keys[0] != "release" || conflict.Keys[1] != "release-it" {
+		t.Errorf("ConflictError.Keys = %v, want [release release-it]", conflict.Keys)
+	}
+
+	result, err := DetectWithOptions(dir, Options{Tool: ToolReleaseIt})
+	if err != nil {
+		t.Fatalf("DetectWithOptions() error = %v", err)
+	}
+	if result.Tool != ToolReleaseIt {
+		t.Errorf("DetectWithOptions() tool = %v, want %v", result.Tool, ToolReleaseIt)
+	}
+
+	result, err = DetectFile(filepath.Join(dir, "package.json"), Options{Tool: ToolSemanticRelease})
+	if err != nil {
+		t.Fatalf("DetectFile() error = %v", err)
+	}
+	if result.Tool != ToolSemanticRelease {
+		t.Errorf("DetectFile() tool = %v, want %v", result.Tool, ToolSemanticRelease)
+	}
+}
+
+func TestParseTool(t *testing.T) {
+	if tool, err := ParseTool("goreleaser"); err != nil || tool != ToolGoReleaser {
+		t.Errorf("ParseTool(goreleaser) = %v, %v", tool, err)
+	}
+	if _, err := ParseTool("bogus"); err == nil {
+		t.Error("ParseTool(bogus) should return error")
+	}
+}
+
+func TestDetect_Profile(t *testing.T) {
+	tests := []struct {
+		name       string
+		files      map[string]string
+		opts       Options
+		wantTool   Tool
+		wantConfig string
+		wantErr    bool
+	}{
+		{
+			name: "goreleaser profile file preferred",
+			files: map[string]string{
+				".goreleaser.yml":     "project_name: oss",
+				".goreleaser.pro.yml": "project_name: pro",
+			},
+			opts:       Options{Profile: "pro"},
+			wantTool:   ToolGoReleaser,
+			wantConfig: ".goreleaser.pro.yml",
+		},
+		{
+			name: "releaserc profile file preferred",
+			files: map[string]string{
+				".releaserc.json":    `{"branches": ["main"]}`,
+				".releaserc.ci.json": `{"branches": ["ci"]}`,
+			},
+			opts:       Options{Profile: "ci"},
+			wantTool:   ToolSemanticRelease,
+			wantConfig: ".releaserc.ci.json",
+		},
+		{
+			name: "falls back to canonical name",
+			files: map[string]string{
+				".goreleaser.yml": "project_name: oss",
+			},
+			opts:       Options{Profile: "pro"},
+			wantTool:   ToolGoReleaser,
+			wantConfig: ".goreleaser.yml",
+		},
+		{
+			name: "later tool's profile file beats earlier canonical config",
+			files: map[string]string{
+				".releaserc.json":     `{"branches": ["main"]}`,
+				".goreleaser.pro.yml": "project_name: pro",
+			},
+			opts:       Options{Profile: "pro"},
+			wantTool:   ToolGoReleaser,
+			wantConfig: ".goreleaser.pro.yml",
+		},
+		{
+			name: "strict finds later tool's profile file",
+			files: map[string]string{
+				".releaserc.json":     `{"branches": ["main"]}`,
+				".goreleaser.pro.yml": "project_name: pro",
+			},
+			opts:       Options{Profile: "pro", Strict: true},
+			wantTool:   ToolGoReleaser,
+			wantConfig: ".goreleaser.pro.yml",
+		},
+		{
+			name: "profile files keep detector priority",
+			files: map[string]string{
+				".releaserc.pro.json": `{"branches": ["main"]}`,
+				".goreleaser.pro.yml": "project_name: pro",
+			},
+			opts:       Options{Profile: "pro"},
+			wantTool:   ToolSemanticRelease,
+			wantConfig: ".releaserc.pro.json",
+		},
+		{
+			name: "strict fails without profile file",
+			files: map[string]string{
+				".goreleaser.yml": "project_name: oss",
+			},
+			opts:    Options{Profile: "pro", Strict: true},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			for filename, content := range tt.files {
+				path := filepath.Join(dir, filename)
+				if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatalf("failed to write test file: %v", err)
+				}
+			}
+
+			result, err := DetectWithOptions(dir, tt.opts)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("DetectWithOptions() should return error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DetectWithOptions() error = %v", err)
+			}
+
+			if result.Tool != tt.wantTool {
+				t.Errorf("DetectWithOptions() tool = %v, want %v", result.Tool, tt.wantTool)
+			}
+
+			if filepath.Base(result.ConfigFile) != tt.wantConfig {
+				t.Errorf("DetectWithOptions() configFile = %v, want %v", result.ConfigFile, tt.wantConfig)
+			}
+		})
+	}
+}
+
+func TestProfileFileName(t *testing.T) {
+	tests := []struct {
+		file string
+		want string
+	}{
+		{".goreleaser.yml", ".goreleaser.pro.yml"},
+		{".releaserc.json", ".releaserc.pro.json"},
+		{".releaserc", ".releaserc.pro"},
+		{"release.config.js", "release.config.pro.js"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			if got := profileFileName(tt.file, "pro"); got != tt.want {
+				t.Errorf("profileFileName(%q) = %q, want %q", tt.file, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectFile(t *testing.T) {
+	tests := []struct {
+		name       string
+		file       string
+		content    string
+		format     string
+		wantTool   Tool
+		wantBranch string
+		wantErr    bool
+	}{
+		{
+			name:       "extensionless releaserc auto-detected",
+			file:       ".releaserc",
+			content:    `{"branches": ["main"]}`,
+			wantTool:   ToolSemanticRelease,
+			wantBranch: "main",
+		},
+		{
+			name:       "forced yaml",
+			file:       ".releaserc",
+			content:    "branches:\n  - trunk",
+			format:     FormatYAML,
+			wantTool:   ToolSemanticRelease,
+			wantBranch: "trunk",
+		},
+		{
+			name:    "forced json rejects yaml",
+			file:    ".versionrc",
+			content: "tagPrefix: v",
+			format:  FormatJSON,
+			wantErr: true,
+		},
+		{
+			name:       "forced toml",
+			file:       ".release-it.conf",
+			content:    "[git]\ntagName = \"v${version}\"",
+			format:     FormatTOML,
+			wantTool:   ToolReleaseIt,
+			wantBranch: "",
+		},
+		{
+			name:    "unknown format",
+			file:    ".releaserc",
+			content: `{}`,
+			format:  "ini",
+			wantErr: true,
+		},
+		{
+			name:    "unrecognized file name",
+			file:    "config.json",
+			content: `{}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write test file: %v", err)
+			}
+
+			result, err := DetectFile(path, Options{InputFormat: tt.format})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("DetectFile() should return error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DetectFile() error = %v", err)
+			}
+
+			if result.Tool != tt.wantTool {
+				t.Errorf("DetectFile() tool = %v, want %v", result.Tool, tt.wantTool)
+			}
+			if tt.wantBranch != "" {
+				branches, _ := result.ConfigData["branches"].([]any)
+				if len(branches) != 1 || branches[0] != tt.wantBranch {
+					t.Errorf("branches = %v, want [%s]", branches, tt.wantBranch)
+				}
+			}
+		})
+	}
+}
+
+func TestDetectFile_Errors(t *testing.T) {
+	dir := t.TempDir()
+	invalid := filepath.Join(dir, ".releaserc.toml")
+	if err := os.WriteFile(invalid, []byte("branches = ["), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	_, err := DetectFile(filepath.Join(dir, "notes.txt"), Options{})
+	if !errors.Is(err, ErrUnsupportedTool) {
+		t.Errorf("unknown file name: error = %v, want ErrUnsupportedTool", err)
+	}
+
+	var detection *DetectionError
+	_, err = DetectFile(filepath.Join(dir, ".releaserc.json"), Options{})
+	if !errors.As(err, &detection) || detection.Op != "read" {
+		t.Errorf("missing file: error = %v, want a read DetectionError", err)
+	}
+	_, err = DetectFile(invalid, Options{})
+	if !errors.As(err, &detection) || detection.Op != "parse" || detection.Path != invalid {
+		t.Errorf("invalid file: error = %v, want a parse DetectionError for %s", err, invalid)
+	}
+
+	if _, err := ParseTool("bumpversion"); !errors.Is(err, ErrUnsupportedTool) {
+		t.Errorf("ParseTool() error = %v, want ErrUnsupportedTool", err)
+	}
+	if _, err := DetectWithOptions(dir, Options{Profile: "ci", Strict: true}); !errors.Is(err, ErrNoConfigFound) {
+		t.Errorf("strict profile: error = %v, want ErrNoConfigFound", err)
+	}
+}
+
+func TestDetect_ParseError(t *testing.T) {
+	tests := []struct {
+		file    string
+		content string
+		want    string
+	}{
+		{".releaserc.json", "{ bad json", "invalid JSON"},
+		{".goreleaser.yaml", "builds: [", "invalid YAML"},
+		{".releaserc", "a: [", "neither JSON"},
+		{".release-it.toml", "git = [", "invalid TOML"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, tt.file)
+			if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+				t.Fatalf("failed to write test file: %v", err)
+			}
+
+			for name, detect := range map[string]func() (*Result, error){
+				"Detect":     func() (*Result, error) { return Detect(dir) },
+				"DetectFile": func() (*Result, error) { return DetectFile(path, Options{}) },
+				"DetectAll": func() (*Result, error) {
+					_, err := DetectAll(dir, Options{})
+					return nil, err
+				},
+			} {
+				_, err := detect()
+				var detection *DetectionError
+				if !errors.As(err, &detection) || detection.Op != "parse" || detection.Path != path {
+					t.Errorf("%s() error = %v, want a parse DetectionError for %s", name, err, path)
+					continue
+				}
+				if errors.Is(err, os.ErrNotExist) || !strings.Contains(err.Error(), tt.want) {
+					t.Errorf("%s() error = %v, want the %s parse error", name, err, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestDetectFile_Remote(t *testing.T) {
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	if _, err := zw.Write([]byte("branches:\n  - main\n")); err != nil {
+		t.Fatalf("failed to gzip: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("failed to gzip: %v", err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/.releaserc.json":
+			_, _ = w.Write([]byte(`{"branches": ["main"]}`))
+		case "/.releaserc.yaml.gz":
+			_, _ = w.Write(gz.Bytes())
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{name: "plain", path: "/.releaserc.json?ref=main"},
+		{name: "gzipped", path: "/.releaserc.yaml.gz"},
+		{name: "not found", path: "/.releaserc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := DetectFile(srv.URL+tt.path, Options{})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("DetectFile() should return error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DetectFile() error = %v", err)
+			}
+			if result.Tool != ToolSemanticRelease {
+				t.Errorf("DetectFile() tool = %v, want %v", result.Tool, ToolSemanticRelease)
+			}
+			branches, _ := result.ConfigData["branches"].([]any)
+			if len(branches) != 1 || branches[0] != "main" {
+				t.Errorf("branches = %v, want [main]", branches)
+			}
+		})
+	}
+}
+
+func TestDetectFile_GzipFile(t *testing.T) {
+	var gz bytes.Buffer
+	zw := gzip.NewWriter(&gz)
+	_, _ = zw.Write([]byte(`{"tagPrefix": "v"}`))
+	_ = zw.Close()
+
+	path := filepath.Join(t.TempDir(), ".versionrc.json.gz")
+	if err := os.WriteFile(path, gz.Bytes(), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	result, err := DetectFile(path, Options{})
+	if err != nil {
+		t.Fatalf("DetectFile() error = %v", err)
+	}
+	if result.Tool != ToolStandardVersion {
+		t.Errorf("DetectFile() tool = %v, want %v", result.Tool, ToolStandardVersion)
+	}
+	if result.ConfigData["tagPrefix"] != "v" {
+		t.Errorf("tagPrefix = %v, want v", result.ConfigData["tagPrefix"])
+	}
+}
+
+func TestToolFromFilename(t *testing.T) {
+	tests := []struct {
+		path string
+		want Tool
+	}{
+		{".releaserc", ToolSemanticRelease},
+		{"configs/.releaserc.ci.json", ToolSemanticRelease},
+		{"release.config.cjs", ToolSemanticRelease},
+		{".release-it.toml", ToolReleaseIt},
+		{".versionrc.json", ToolStandardVersion},
+		{".goreleaser.pro.yml", ToolGoReleaser},
+		{"goreleaser.yaml", ToolGoReleaser},
+		{"configs/api.releaserc.json", ToolSemanticRelease},
+		{"web.release-it.json", ToolReleaseIt},
+		{"cli.goreleaser.yaml", ToolGoReleaser},
+		{".changeset/config.json", ToolChangesets},
+		{"config.json", ToolNone},
+		{"api.release.config.yaml", ToolNone},
+		{"package.json", ToolNone},
+		{"README.md", ToolNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := ToolFromFilename(tt.path); got != tt.want {
+				t.Errorf("ToolFromFilename(%q) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func contains(s, substr string) bool {
+	return filepath.Base(s) == substr || s == substr ||
+		(len(s) > len(substr) && s[len(s)-len(substr):] == substr)
+}
+
+func TestDetect_MavenRelease(t *testing.T) {
+	tests := []struct {
+		name     string
+		pom      string
+		wantTool Tool
+		wantTag  string
+		wantSCM  string
+	}{
+		{
+			name: "release plugin with tagNameFormat",
+			pom: `<?xml version="1.0" encoding="UTF-8"?>
+<project xmlns="http://maven.apache.org/POM/4.0.0">
+  <artifactId>demo</artifactId>
+  <scm>
+    <connection>scm:git:https://github.com/acme/demo.git</connection>
+    <url>https://github.com/acme/demo</url>
+  </scm>
+  <build>
+    <plugins>
+      <plugin>
+        <groupId>org.apache.maven.plugins</groupId>
+        <artifactId>maven-release-plugin</artifactId>
+        <configuration>
+          <tagNameFormat>v@{project.version}</tagNameFormat>
+        </configuration>
+      </plugin>
+    </plugins>
+  </build>
+</project>`,
+			wantTool: ToolMavenRelease,
+			wantTag:  "v@{project.version}",
+			wantSCM:  "https://github.com/acme/demo",
+		},
+		{
+			name: "release plugin in pluginManagement",
+			pom: `<project>
+  <artifactId>demo</artifactId>
+  <build><pluginManagement><plugins><plugin>
+    <artifactId>maven-release-plugin</artifactId>
+  </plugin></plugins></pluginManagement></build>
+</project>`,
+			wantTool: ToolMavenRelease,
+		},
+		{
+			name:     "pom without release plugin",
+			pom:      `<project><artifactId>demo</artifactId></project>`,
+			wantTool: ToolNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, "pom.xml"), []byte(tt.pom), 0644); err != nil {
+				t.Fatalf("failed to write test file: %v", err)
+			}
+
+			result, err := Detect(dir)
+			if err != nil {
+				t.Fatalf("Detect() error = %v", err)
+			}
+			if result.Tool != tt.wantTool {
+				t.Fatalf("Detect() tool = %v, want %v", result.Tool, tt.wantTool)
+			}
+			if tt.wantTool == ToolNone {
+				return
+			}
+
+			if got, _ := result.Details["tagNameFormat"].(string); got != tt.wantTag {
+				t.Errorf("tagNameFormat = %q, want %q", got, tt.wantTag)
+			}
+			if got, _ := result.Details["scmUrl"].(string); got != tt.wantSCM {
+				t.Errorf("scmUrl = %q, want %q", got, tt.wantSCM)
+			}
+		})
+	}
+}
+
+func TestDetect_LernaChangelogLabels(t *testing.T) {
+	dir := t.TempDir()
+	releaseIt := `{"plugins": {"@release-it-plugins/lerna-changelog": {"infile": "CHANGELOG.md"}}}`
+	if err := os.WriteFile(filepath.Join(dir, ".release-it.json"), []byte(releaseIt), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	lerna := `{"changelog": {"labels": {"type: feature": "Features", "type: bug": "Fixes", "docs": "Features"}}}`
+	if err := os.WriteFile(filepath.Join(dir, "lerna.json"), []byte(lerna), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	result, err := Detect(dir)
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	want := []any{
+		map[string]any{"label": "type: feature", "section": "Features"},
+		map[string]any{"label": "type: bug", "section": "Fixes"},
+		map[string]any{"label": "docs", "section": "Features"},
+	}
+	if !reflect.DeepEqual(result.Details["changelogLabels"], want) {
+		t.Errorf(`Details["changelogLabels"] = %v, want %v`, result.Details["changelogLabels"], want)
+	}
+	if got, _ := result.Details["changelogLabelsFile"].(string); got != filepath.Join(dir, "lerna.json") {
+		t.Errorf(`Details["changelogLabelsFile"] = %q`, got)
+	}
+}
+
+func TestDetect_ChangesetsPre(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, ".changeset"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ".changeset", "config.json"), []byte(`{"baseBranch": "main", "access": "public"}`), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	result, err := Detect(dir)
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if result.Tool != ToolChangesets {
+		t.Fatalf("Detect() tool = %v, want %v", result.Tool, ToolChangesets)
+	}
+	if _, ok := result.Details["pre"]; ok {
+		t.Errorf(`Details["pre"] = %v without pre.json`, result.Details["pre"])
+	}
+
+	pre := `{"mode": "pre", "tag": "next", "initialVersions": {"app": "1.4.0"}, "changesets": []}`
+	if err := os.WriteFile(filepath.Join(dir, ".changeset", "pre.json"), []byte(pre), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	for _, detect := range []func() (*Result, error){
+		func() (*Result, error) { return Detect(dir) },
+		func() (*Result, error) { return DetectFile(filepath.Join(dir, ".changeset", "config.json"), Options{}) },
+	} {
+		result, err := detect()
+		if err != nil {
+			t.Fatalf("detect error = %v", err)
+		}
+		pre, _ := result.Details["pre"].(map[string]any)
+		if pre["tag"] != "next" {
+			t.Errorf(`Details["pre"] = %v, want tag next`, result.Details["pre"])
+		}
+		if got, _ := result.Details["preFile"].(string); got != filepath.Join(dir, ".changeset", "pre.json") {
+			t.Errorf(`Details["preFile"] = %q`, got)
+		}
+	}
+
+	// A malformed pre.json is reported, not taken for a missing one
+	if err := os.WriteFile(filepath.Join(dir, ".changeset", "pre.json"), []byte(`{"mode": "pre",`), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	result, err = Detect(dir)
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if _, ok := result.Details["pre"]; ok {
+		t.Errorf(`Details["pre"] = %v for a malformed pre.json`, result.Details["pre"])
+	}
+	if len(result.Warnings) != 1 || !strings.Contains(result.Warnings[0], "pre.json: invalid JSON") {
+		t.Errorf("Warnings = %v, want pre.json reported as invalid JSON", result.Warnings)
+	}
+}
+
+func TestDetect_TaskRunner(t *testing.T) {
+	tests := []struct {
+		name       string
+		files      map[string]string
+		wantTool   Tool
+		wantFile   string
+		wantVia    string
+		wantConfig bool
+	}{
+		{
+			name: "makefile release target",
+			files: map[string]string{
+				"Makefile": ".PHONY: release\nrelease:\n\t@npx semantic-release --no-ci\n",
+			},
+			wantTool: ToolSemanticRelease,
+			wantFile: "Makefile",
+			wantVia:  "Makefile",
+		},
+		{
+			name: "taskfile with non-standard goreleaser config",
+			files: map[string]string{
+				"Taskfile.yml":         "version: '3'\ntasks:\n  release:\n    cmds:\n      - goreleaser release --clean -f build/goreleaser.yml\n",
+				"build/goreleaser.yml": "project_name: demo\n",
+			},
+			wantTool:   ToolGoReleaser,
+			wantFile:   "build/goreleaser.yml",
+			wantVia:    "Taskfile",
+			wantConfig: true,
+		},
+		{
+			name: "commented invocation",
+			files: map[string]string{
+				"Makefile": "release:\n\t# goreleaser release\n\techo done\n",
+			},
+			wantTool: ToolNone,
+		},
+		{
+			name: "plugin package name is not an invocation",
+			files: map[string]string{
+				"Makefile": "deps:\n\tnpm install @semantic-release/git\n",
+			},
+			wantTool: ToolNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				path := filepath.Join(dir, name)
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatalf("failed to write test file: %v", err)
+				}
+			}
+
+			result, err := Detect(dir)
+			if err != nil {
+				t.Fatalf("Detect() error = %v", err)
+			}
+			if result.Tool != tt.wantTool {
+				t.Fatalf("Detect() tool = %v, want %v", result.Tool, tt.wantTool)
+			}
+			if tt.wantTool == ToolNone {
+				return
+			}
+
+			if want := filepath.Join(dir, tt.wantFile); result.ConfigFile != want {
+				t.Errorf("ConfigFile = %q, want %q", result.ConfigFile, want)
+			}
+			if got := result.Details["detectedVia"]; got != tt.wantVia {
+				t.Errorf("detectedVia = %v, want %q", got, tt.wantVia)
+			}
+			if tt.wantConfig && result.ConfigData["project_name"] != "demo" {
+				t.Errorf("ConfigData = %v, want the goreleaser config", result.ConfigData)
+			}
+		})
+	}
+}
+
+func TestDetect_Prefer(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		".releaserc.json":  `{"branches": ["main"]}`,
+		".release-it.json": `{"git": {"tagName": "v${version}"}}`,
+		"package.json":     `{"devDependencies": {"standard-version": "^9.0.0"}}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write test file: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		prefer []Tool
+		want   Tool
+	}{
+		{"registration order", nil, ToolSemanticRelease},
+		{"preferred tool first", []Tool{ToolReleaseIt}, ToolReleaseIt},
+		{"first preference found wins", []Tool{ToolGoReleaser, ToolReleaseIt}, ToolReleaseIt},
+		{"config file beats preferred fallback", []Tool{ToolStandardVersion}, ToolSemanticRelease},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := DetectWithOptions(dir, Options{Prefer: tt.prefer})
+			if err != nil {
+				t.Fatalf("DetectWithOptions() error = %v", err)
+			}
+			if result.Tool != tt.want {
+				t.Errorf("Tool = %v, want %v", result.Tool, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetect_InferredFromDependencies(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		wantTool Tool
+		inferred bool
+	}{
+		{
+			name: "semantic-release in devDependencies",
+			files: map[string]string{
+				"package.json": `{"name": "demo", "devDependencies": {"semantic-release": "^24.0.0"}}`,
+			},
+			wantTool: ToolSemanticRelease,
+			inferred: true,
+		},
+		{
+			name: "release-it in dependencies",
+			files: map[string]string{
+				"package.json": `{"dependencies": {"release-it": "^17.0.0"}}`,
+			},
+			wantTool: ToolReleaseIt,
+			inferred: true,
+		},
+		{
+			name: "config file wins",
+			files: map[string]string{
+				"package.json":     `{"devDependencies": {"semantic-release": "^24.0.0"}}`,
+				".release-it.json": `{"git": {"tagName": "v${version}"}}`,
+			},
+			wantTool: ToolReleaseIt,
+		},
+		{
+			name: "plugin alone is not the tool",
+			files: map[string]string{
+				"package.json": `{"devDependencies": {"@semantic-release/git": "^10.0.0"}}`,
+			},
+			wantTool: ToolNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+					t.Fatalf("failed to write test file: %v", err)
+				}
+			}
+
+			result, err := Detect(dir)
+			if err != nil {
+				t.Fatalf("Detect() error = %v", err)
+			}
+			if result.Tool != tt.wantTool {
+				t.Fatalf("Detect() tool = %v, want %v", result.Tool, tt.wantTool)
+			}
+			if got := result.Details["inferredFromDependencies"] == true; got != tt.inferred {
+				t.Errorf("inferredFromDependencies = %v, want %v", got, tt.inferred)
+			}
+			if tt.inferred && (len(result.ConfigData) != 0 || len(result.Warnings) != 1) {
+				t.Errorf("ConfigData = %v, Warnings = %v, want no config and one warning", result.ConfigData, result.Warnings)
+			}
+		})
+	}
+}
+
+func TestDetectContext_Canceled(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ".releaserc.json"), []byte(`{"branches": ["main"]}`), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := DetectContext(ctx, dir); !errors.Is(err, context.Canceled) {
+		t.Errorf("DetectContext() error = %v, want context.Canceled", err)
+	}
+
+	result, err := DetectContext(context.Background(), dir)
+	if err != nil {
+		t.Fatalf("DetectContext() error = %v", err)
+	}
+	if result.Tool != ToolSemanticRelease {
+		t.Errorf("DetectContext() tool = %v, want %v", result.Tool, ToolSemanticRelease)
+	}
+}
+
+func TestDir_PackageJSONReadOnce(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "package.json")
+	if err := os.WriteFile(path, []byte(`{"release": {"branches": ["main"]}}`), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	d := NewDir(dir, Options{})
+	first, err := d.PackageJSON()
+	if err != nil {
+		t.Fatalf("PackageJSON() error = %v", err)
+	}
+
+	// A change after the first read must not be observed mid-scan
+	if err := os.WriteFile(path, []byte(`{"release-it": {}}`), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	second, err := d.PackageJSON()
+	if err != nil {
+		t.Fatalf("PackageJSON() error = %v", err)
+	}
+	if _, ok := second["release"]; !ok || len(second) != len(first) {
+		t.Errorf("PackageJSON() = %v, want the first parse %v", second, first)
+	}
+
+	if _, err := NewDir(t.TempDir(), Options{}).PackageJSON(); err == nil {
+		t.Error("PackageJSON() should return error when package.json is missing")
+	}
+}
+
+func TestToolInfos(t *testing.T) {
+	infos := ToolInfos()
+	tools := Tools()
+	if len(infos) != len(tools) {
+		t.Fatalf("ToolInfos() has %d entries, Tools() has %d", len(infos), len(tools))
+	}
+	for i, info := range infos {
+		if info.Tool != tools[i] {
+			t.Errorf("ToolInfos()[%d].Tool = %v, want %v", i, info.Tool, tools[i])
+		}
+		if len(info.ConfigFiles) == 0 {
+			t.Errorf("%s lists no config files", info.Tool)
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	saved := registry
+	defer func() { registry = saved }()
+
+	const toolCustom Tool = "custom"
+	Register(Detector{
+		Tool:        toolCustom,
+		Priority:    5,
+		ConfigFiles: []string{"custom.json"},
+		Detect: func(d *Dir) (*Result, error) {
+			path := filepath.Join(d.Path, "custom.json")
+			if _, err := os.Stat(path); err != nil {
+				return nil, nil
+			}
+			return &Result{Tool: toolCustom, ConfigFile: path}, nil
+		},
+	})
+
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"custom.json":     `{}`,
+		".releaserc.json": `{"branches": ["main"]}`,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write test file: %v", err)
+		}
+	}
+
+	result, err := Detect(dir)
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if result.Tool != toolCustom {
+		t.Errorf("Detect() tool = %v, want %v (lower priority runs first)", result.Tool, toolCustom)
+	}
+
+	if tools := Tools(); tools[0] != toolCustom {
+		t.Errorf("Tools()[0] = %v, want %v", tools[0], toolCustom)
+	}
+}
+
+func TestDetectAll(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		".releaserc.json":  `{"tagFormat": "v${version}"}`,
+		".goreleaser.yaml": "project_name: demo\n",
+		"Makefile":         "release:\n\tgoreleaser release\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write test file: %v", err)
+		}
+	}
+
+	results, err := DetectAll(dir, Options{})
+	if err != nil {
+		t.Fatalf("DetectAll() error = %v", err)
+	}
+
+	var tools []Tool
+	for _, r := range results {
+		tools = append(tools, r.Tool)
+	}
+	want := []Tool{ToolSemanticRelease, ToolGoReleaser}
+	if !reflect.DeepEqual(tools, want) {
+		t.Errorf("DetectAll() tools = %v, want %v", tools, want)
+	}
+	if len(results) == 2 && filepath.Base(results[1].ConfigFile) != ".goreleaser.yaml" {
+		t.Errorf("goreleaser ConfigFile = %q, want the config file over the Makefile", results[1].ConfigFile)
+	}
+}
+
+func TestFileCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "package.json")
+	if err := os.WriteFile(path, []byte(`{"name":"a"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cache := NewCache()
+	first, err := cache.readFile(path)
+	if err != nil {
+		t.Fatalf("readFile() error = %v", err)
+	}
+	second, err := cache.readFile(path)
+	if err != nil {
+		t.Fatalf("readFile() error = %v", err)
+	}
+	if &first[0] != &second[0] {
+		t.Error("unchanged file was read again, want the cached contents")
+	}
+
+	// A newer modification time invalidates the entry
+	if err := os.WriteFile(path, []byte(`{"name":"b"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+	pkg, err := readPackageJSON(path, cache)
+	if err != nil {
+		t.Fatalf("readPackageJSON() error = %v", err)
+	}
+	if pkg["name"] != "b" {
+		t.Errorf("name = %v, want b after the file changed", pkg["name"])
+	}
+
+	if _, err := cache.readFile(filepath.Join(t.TempDir(), "missing.json")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("readFile() error = %v, want os.ErrNotExist", err)
+	}
+	var none *Cache
+	if data, err := none.readFile(path); err != nil || string(data) != `{"name":"b"}` {
+		t.Errorf("nil cache readFile() = %q, %v", data, err)
+	}
+}
+
+func TestDetectRecursive(t *testing.T) {
+	tests := []struct {
+		name          string
+		files         map[string]string
+		wantDirs      []string
+		wantWorkspace bool
+	}{
+		{
+			name: "yarn workspaces",
+			files: map[string]string{
+				"package.json":                                  `{"private": true, "workspaces": ["packages/**"]}`,
+				".releaserc.json":                               `{"branches": ["main"]}`,
+				"packages/api/package.json":                     `{"name": "api"}`,
+				"packages/api/.releaserc.json":                  `{"tagFormat": "api-v${version}"}`,
+				"packages/api/node_modules/dep/package.json":    `{"name": "dep"}`,
+				"packages/api/node_modules/dep/.releaserc.json": `{"tagFormat": "v${version}"}`,
+				"tools/.release-it.json":                        `{}`,
+			},
+			wantDirs:      []string{".", "packages/api"},
+			wantWorkspace: true,
+		},
+		{
+			name: "pnpm workspace with exclusion",
+			files: map[string]string{
+				"pnpm-workspace.yaml":          "packages:\n  - 'apps/*'\n  - '!apps/legacy'\n",
+				"apps/web/.release-it.json":    `{"git": {"tagName": "web@${version}"}}`,
+				"apps/legacy/.release-it.json": `{}`,
+			},
+			wantDirs:      []string{"apps/web"},
+			wantWorkspace: true,
+		},
+		{
+			name: "no workspace",
+			files: map[string]string{
+				"svc/.goreleaser.yaml":             "project_name: svc\n",
+				"node_modules/dep/.releaserc.json": `{}`,
+				".cache/.releaserc.json":           `{}`,
+			},
+			wantDirs: []string{"svc"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			for name, content := range tt.files {
+				path := filepath.Join(root, name)
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatalf("failed to create test dir: %v", err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatalf("failed to write test file: %v", err)
+				}
+			}
+
+			packages, err := DetectRecursive(context.Background(), root, Options{})
+			if err != nil {
+				t.Fatalf("DetectRecursive() error = %v", err)
+			}
+
+			var dirs []string
+			for _, pkg := range packages {
+				rel, _ := filepath.Rel(root, pkg.Dir)
+				dirs = append(dirs, filepath.ToSlash(rel))
+				_, isPackage := pkg.Result.Details["workspacePackage"]
+				if want := tt.wantWorkspace && rel != "."; isPackage != want {
+					t.Errorf("%s workspacePackage = %v, want %v", rel, isPackage, want)
+				}
+			}
+			if !reflect.DeepEqual(dirs, tt.wantDirs) {
+				t.Errorf("DetectRecursive() dirs = %v, want %v", dirs, tt.wantDirs)
+			}
+		})
+	}
+}
+
+func TestDefaultBranch(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  string
+	}{
+		{
+			name:  "remote default",
+			files: map[string]string{".git/HEAD": "ref: refs/heads/feature\n", ".git/refs/remotes/origin/HEAD": "ref: refs/remotes/origin/trunk\n"},
+			want:  "trunk",
+		},
+		{
+			name:  "checked-out branch",
+			files: map[string]string{".git/HEAD": "ref: refs/heads/master\n"},
+			want:  "master",
+		},
+		{
+			name:  "detached",
+			files: map[string]string{".git/HEAD": "4b825dc642cb6eb9a060e54bf8d69288fbee4904\n"},
+			want:  "",
+		},
+		{
+			name: "worktree",
+			files: map[string]string{
+				".git":                               "gitdir: main/.git/worktrees/wt\n",
+				"main/.git/worktrees/wt/HEAD":        "ref: refs/heads/wt\n",
+				"main/.git/worktrees/wt/commondir":   "../..\n",
+				"main/.git/refs/remotes/origin/HEAD": "ref: refs/remotes/origin/develop\n",
+			},
+			want: "develop",
+		},
+		{
+			name: "not a repository",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			for name, content := range tt.files {
+				path := filepath.Join(root, name)
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatalf("failed to create test dir: %v", err)
+				}
+				if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+					t.Fatalf("failed to write test file: %v", err)
+				}
+			}
+			sub := filepath.Join(root, "sub")
+			if err := os.MkdirAll(sub, 0755); err != nil {
+				t.Fatalf("failed to create test dir: %v", err)
+			}
+
+			if got := DefaultBranch(sub); got != tt.want {
+				t.Errorf("DefaultBranch() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("option overrides git", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(dir, ".goreleaser.yaml"), []byte("project_name: demo\n"), 0644); err != nil {
+			t.Fatalf("failed to write test file: %v", err)
+		}
+		result, err := DetectWithOptions(dir, Options{DefaultBranch: "develop"})
+		if err != nil {
+			t.Fatalf("DetectWithOptions() error = %v", err)
+		}
+		if got := result.Details["defaultBranch"]; got != "develop" {
+			t.Errorf(`Details["defaultBranch"] = %v, want develop`, got)
+		}
+	})
+}
+
+func TestDetect_PackageName(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		".releaserc.json": `{"branches": ["main"]}`,
+		"package.json":    `{"name": "@scope/app", "version": "1.0.0"}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write test file: %v", err)
+		}
+	}
+
+	result, err := Detect(dir)
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if result.Details["packageName"] != "@scope/app" {
+		t.Errorf(`Details["packageName"] = %v, want @scope/app`, result.Details["packageName"])
+	}
+
+	result, err = DetectFile(filepath.Join(dir, ".releaserc.json"), Options{})
+	if err != nil {
+		t.Fatalf("DetectFile() error = %v", err)
+	}
+	if result.Details["packageName"] != "@scope/app" {
+		t.Errorf(`DetectFile Details["packageName"] = %v, want @scope/app`, result.Details["packageName"])
+	}
+}
+
+func TestDetect_SearchUp(t *testing.T) {
+	outer := t.TempDir()
+	root := filepath.Join(outer, "repo")
+	sub := filepath.Join(root, "packages", "api")
+	if err := os.MkdirAll(filepath.Join(root, ".git"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(sub, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, ".releaserc.json"), []byte(`{"branches": ["main"]}`), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	result, err := DetectWithOptions(sub, Options{})
+	if err != nil {
+		t.Fatalf("DetectWithOptions() error = %v", err)
+	}
+	if result.Tool != ToolNone {
+		t.Errorf("without SearchUp tool = %v, want none", result.Tool)
+	}
+
+	result, err = DetectWithOptions(sub, Options{SearchUp: true})
+	if err != nil {
+		t.Fatalf("DetectWithOptions() error = %v", err)
+	}
+	if result.Tool != ToolSemanticRelease {
+		t.Fatalf("with SearchUp tool = %v, want %v", result.Tool, ToolSemanticRelease)
+	}
+	if result.Details["dir"] != root {
+		t.Errorf(`Details["dir"] = %v, want %s`, result.Details["dir"], root)
+	}
+
+	// The search stops at the work tree root
+	if err := os.WriteFile(filepath.Join(outer, ".releaserc.json"), []byte(`{}`), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	if err := os.Remove(filepath.Join(root, ".releaserc.json")); err != nil {
+		t.Fatal(err)
+	}
+	result, err = DetectWithOptions(sub, Options{SearchUp: true})
+	if err != nil {
+		t.Fatalf("DetectWithOptions() error = %v", err)
+	}
+	if result.Tool != ToolNone {
+		t.Errorf("beyond the work tree tool = %v, want none", result.Tool)
+	}
+}
+
+func TestDetect_SemanticReleaseExtends(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ".releaserc.yml"), []byte("extends: my-shared-config\n"), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	result, err := Detect(dir)
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if result.Tool != ToolSemanticRelease {
+		t.Fatalf("Detect() tool = %v, want %v", result.Tool, ToolSemanticRelease)
+	}
+	extends, _ := result.Details["extends"].([]string)
+	if len(extends) != 1 || extends[0] != "my-shared-config" {
+		t.Errorf(`Details["extends"] = %v, want [my-shared-config]`, result.Details["extends"])
+	}
+}
+
+func TestDetect_GoReleaserIncludes(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/shared/release.yml" {
+			_, _ = w.Write([]byte("release:\n  draft: true\n"))
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	files := map[string]string{
+		".goreleaser.yaml": `version: 2
+includes:
+  - from_file:
+      path: ./config/build.yaml
+  - from_url:
+      url: ` + srv.URL + `/shared/release.yml
+  - from_file:
+      path: ./config/missing.yaml
+project_name: demo
+---
+changelog:
+  disable: true
+`,
+		"config/build.yaml": `includes:
+  - from_file:
+      path: archives.yaml
+project_name: shared
+builds:
+  - binary: demo
+    goos: [linux]
+`,
+		"config/archives.yaml": "archives:\n  - formats: [zip]\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create test dir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write test file: %v", err)
+		}
+	}
+
+	result, err := Detect(dir)
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+
+	data := result.ConfigData
+	if data["project_name"] != "demo" {
+		t.Errorf("project_name = %v, want the main config to win", data["project_name"])
+	}
+	if _, ok := data["builds"]; !ok {
+		t.Error("builds from the included file are missing")
+	}
+	if _, ok := data["archives"]; !ok {
+		t.Error("archives from the nested include are missing")
+	}
+	if release, _ := data["release"].(map[string]any); release["draft"] != true {
+		t.Errorf("release = %v, want draft from the URL include", data["release"])
+	}
+	if changelog, _ := data["changelog"].(map[string]any); changelog["disable"] != true {
+		t.Errorf("changelog = %v, want the second YAML document merged", data["changelog"])
+	}
+	if _, ok := data["includes"]; ok {
+		t.Error("includes should be resolved, not kept")
+	}
+	if included, _ := result.Details["includes"].([]string); len(included) != 3 {
+		t.Errorf(`Details["includes"] = %v, want 3 files`, result.Details["includes"])
+	}
+	if len(result.Warnings) != 1 || !strings.Contains(result.Warnings[0], "missing.yaml") {
+		t.Errorf("Warnings = %v, want one for the missing include", result.Warnings)
+	}
+}
+
+func TestDetect_GoReleaserRemoteIncludes(t *testing.T) {
+	var fetches atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		_, _ = w.Write([]byte("release:\n  draft: true\n"))
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	config := "includes:\n  - from_url:\n      url: " + srv.URL + "/release.yml\nproject_name: demo\n"
+	if err := os.WriteFile(filepath.Join(dir, ".goreleaser.yaml"), []byte(config), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	// One cache per run fetches the include once across detections
+	opts := Options{Cache: NewCache()}
+	if _, err := DetectWithOptions(dir, opts); err != nil {
+		t.Fatalf("DetectWithOptions() error = %v", err)
+	}
+	results, err := DetectAll(dir, opts)
+	if err != nil {
+		t.Fatalf("DetectAll() error = %v", err)
+	}
+	if release, _ := results[0].ConfigData["release"].(map[string]any); release["draft"] != true {
+		t.Errorf("release = %v, want draft from the cached URL include", results[0].ConfigData["release"])
+	}
+	if n := fetches.Load(); n != 1 {
+		t.Errorf("include fetched %d times, want 1", n)
+	}
+
+	fetches.Store(0)
+	result, err := DetectWithOptions(dir, Options{SkipRemoteIncludes: true})
+	if err != nil {
+		t.Fatalf("DetectWithOptions() error = %v", err)
+	}
+	if n := fetches.Load(); n != 0 {
+		t.Errorf("include fetched %d times with SkipRemoteIncludes, want 0", n)
+	}
+	if _, ok := result.ConfigData["release"]; ok {
+		t.Error("release from the skipped include should be missing")
+	}
+	if result.ConfigData["project_name"] != "demo" {
+		t.Errorf("project_name = %v, want demo", result.ConfigData["project_name"])
+	}
+	if len(result.Warnings) != 1 || !strings.Contains(result.Warnings[0], "was not fetched") {
+		t.Errorf("Warnings = %v, want one for the skipped include", result.Warnings)
+	}
 }
@@ -0,0 +1,39 @@
+package detector
+
+import "path/filepath"
+
+// Dir is a project directory being scanned for release tool config. It
+// reads files that several detectors look at, such as package.json and
+// the Makefile, only once.
+type Dir struct {
+	Path    string
+	Options Options
+
+	pkgRead bool
+	pkg     map[string]any
+	pkgErr  error
+
+	callsRead bool
+	calls     []taskRunnerCall
+}
+
+// NewDir returns a Dir for scanning path with opts.
+func NewDir(path string, opts Options) *Dir {
+	return &Dir{Path: path, Options: opts}
+}
+
+// PackageJSON returns the parsed package.json of the directory. The file
+// is read and parsed on the first call; later calls return the same
+// result.
+func (d *Dir) PackageJSON() (map[string]any, error) {
+	if !d.pkgRead {
+		d.pkg, d.pkgErr = readPackageJSON(d.packageJSONPath(), d.Options.Cache)
+		d.pkgRead = true
+	}
+	return d.pkg, d.pkgErr
+}
+
+// packageJSONPath returns the path of the directory's package.json.
+func (d *Dir) packageJSONPath() string {
+	return filepath.Join(d.Path, "package.json")
+}
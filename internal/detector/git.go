@@ -0,0 +1,85 @@
+package detector
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultBranch returns the default branch of the git repository that
+// contains dir. It prefers the remote's default (refs/remotes/origin/HEAD,
+// as set by git clone) and falls back to the checked-out branch. It
+// returns "" when dir is not in a repository or HEAD is detached.
+func DefaultBranch(dir string) string {
+	gitDir, commonDir := findGitDir(dir)
+	if gitDir == "" {
+		return ""
+	}
+	if branch, ok := readSymref(filepath.Join(commonDir, "refs", "remotes", "origin", "HEAD"), "refs/remotes/origin/"); ok {
+		return branch
+	}
+	branch, _ := readSymref(filepath.Join(gitDir, "HEAD"), "refs/heads/")
+	return branch
+}
+
+// findGitDir walks up from dir to the repository's git directory. A .git
+// file (worktrees, submodules) is followed to the directory it names;
+// commonDir is where shared refs live for a worktree.
+func findGitDir(dir string) (gitDir, commonDir string) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", ""
+	}
+	for {
+		candidate := filepath.Join(abs, ".git")
+		if info, err := os.Stat(candidate); err == nil {
+			if info.IsDir() {
+				return candidate, candidate
+			}
+			return gitDirFromFile(candidate)
+		}
+		parent := filepath.Dir(abs)
+		if parent == abs {
+			return "", ""
+		}
+		abs = parent
+	}
+}
+
+// gitDirFromFile resolves a "gitdir: <path>" file.
+func gitDirFromFile(path string) (gitDir, commonDir string) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", ""
+	}
+	target, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir:")
+	if !ok {
+		return "", ""
+	}
+	gitDir = strings.TrimSpace(target)
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(filepath.Dir(path), gitDir)
+	}
+	commonDir = gitDir
+	if data, err := os.ReadFile(filepath.Join(gitDir, "commondir")); err == nil {
+		commonDir = strings.TrimSpace(string(data))
+		if !filepath.IsAbs(commonDir) {
+			commonDir = filepath.Join(gitDir, commonDir)
+		}
+	}
+	return gitDir, commonDir
+}
+
+// readSymref reads a "ref: <prefix><name>" file and returns name.
+func readSymref(path, prefix string) (string, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", false
+	}
+	ref, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "ref:")
+	if !ok {
+		return "", false
+	}
+	name, ok := strings.CutPrefix(strings.TrimSpace(ref), prefix)
+	return name, ok && name != ""
+}
@@ -0,0 +1,145 @@
+package detector
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// maxIncludeDepth bounds nested GoReleaser includes.
+const maxIncludeDepth = 5
+
+// githubRawURL is where GoReleaser fetches a from_url include given
+// without a scheme, such as "caarlos0/goreleaserfiles/main/build.yml".
+const githubRawURL = "https://raw.githubusercontent.com/"
+
+// parseYAML parses every document of a YAML stream and merges them in
+// order, so a later document overrides an earlier one.
+func parseYAML(data []byte) (map[string]any, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	var result map[string]any
+	for {
+		var doc map[string]any
+		err := dec.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if result == nil {
+			result = doc
+		} else {
+			mergeConfig(result, doc)
+		}
+	}
+	return result, nil
+}
+
+// mergeConfig merges src into dst. Nested maps are merged key by key;
+// any other value in src, lists included, replaces the one in dst.
+func mergeConfig(dst, src map[string]any) {
+	for key, value := range src {
+		if from, ok := value.(map[string]any); ok {
+			if into, ok := dst[key].(map[string]any); ok {
+				mergeConfig(into, from)
+				continue
+			}
+		}
+		dst[key] = value
+	}
+}
+
+// resolveGoReleaserIncludes merges the files named in a GoReleaser config's
+// includes section (from_file paths and from_url URLs) under the config
+// itself, which wins on conflicts. It returns the merged config, the
+// locations included and a warning for each include that could not be
+// read.
+func resolveGoReleaserIncludes(location string, data map[string]any, opts Options) (map[string]any, []string, []string) {
+	var included, warnings []string
+	merged := resolveIncludes(location, data, opts, 0, &included, &warnings)
+	return merged, included, warnings
+}
+
+func resolveIncludes(location string, data map[string]any, opts Options, depth int, included, warnings *[]string) map[string]any {
+	includes, ok := data["includes"].([]any)
+	if !ok {
+		return data
+	}
+	delete(data, "includes")
+	if depth >= maxIncludeDepth {
+		*warnings = append(*warnings, fmt.Sprintf("includes nested deeper than %d levels in %s are ignored", maxIncludeDepth, location))
+		return data
+	}
+
+	merged := make(map[string]any)
+	for _, entry := range includes {
+		target, err := includeLocation(location, entry)
+		if err != nil {
+			*warnings = append(*warnings, fmt.Sprintf("cannot resolve include in %s: %v", location, err))
+			continue
+		}
+		if isURL(target) && opts.SkipRemoteIncludes {
+			*warnings = append(*warnings, fmt.Sprintf("include %s was not fetched; the config may be incomplete", target))
+			continue
+		}
+		raw, err := readSource(target, opts)
+		if err != nil {
+			*warnings = append(*warnings, fmt.Sprintf("include %s could not be read: %v", target, err))
+			continue
+		}
+		content, err := parseConfig(sourceName(target), raw, "")
+		if err != nil {
+			*warnings = append(*warnings, fmt.Sprintf("include %s could not be parsed", target))
+			continue
+		}
+		*included = append(*included, target)
+		mergeConfig(merged, resolveIncludes(target, content, opts, depth+1, included, warnings))
+	}
+	mergeConfig(merged, data)
+	return merged
+}
+
+// includeLocation returns the path or URL an includes entry refers to.
+// from_file paths are relative to the including config.
+func includeLocation(base string, entry any) (string, error) {
+	include, _ := entry.(map[string]any)
+	if from, ok := include["from_file"].(map[string]any); ok {
+		p, _ := from["path"].(string)
+		if p == "" {
+			return "", errors.New("from_file has no path")
+		}
+		if isURL(base) {
+			baseURL, err := url.Parse(base)
+			if err != nil {
+				return "", err
+			}
+			ref, err := url.Parse(filepath.ToSlash(p))
+			if err != nil {
+				return "", err
+			}
+			return baseURL.ResolveReference(ref).String(), nil
+		}
+		if filepath.IsAbs(p) {
+			return p, nil
+		}
+		return filepath.Join(filepath.Dir(base), p), nil
+	}
+	if from, ok := include["from_url"].(map[string]any); ok {
+		u, _ := from["url"].(string)
+		if u == "" {
+			return "", errors.New("from_url has no url")
+		}
+		if !isURL(u) {
+			u = githubRawURL + strings.TrimPrefix(u, "/")
+		}
+		return u, nil
+	}
+	return "", errors.New("entry has neither from_file nor from_url")
+}
@@ -0,0 +1,333 @@
+package detector
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// jsExportPattern finds the exported config in a CommonJS or ES module.
+var jsExportPattern = regexp.MustCompile(`(?:module\.exports\s*=|export\s+default)\s*`)
+
+// jsConfigExts lists the extensions of JavaScript and TypeScript configs.
+var jsConfigExts = map[string]bool{
+	".js":  true,
+	".cjs": true,
+	".mjs": true,
+	".ts":  true,
+}
+
+// parseJSConfig extracts the static object literal a JavaScript config
+// exports, from either module.exports = {...} or export default {...}.
+// A wrapper call such as defineConfig({...}) and an exported const are
+// followed to the literal. Anything computed at runtime, such as
+// variables, spreads or interpolated template strings, is an error.
+func parseJSConfig(src []byte) (map[string]any, error) {
+	p := &jsParser{src: string(src)}
+	loc := jsExportPattern.FindStringIndex(p.src)
+	if loc == nil {
+		return nil, errors.New("no module.exports or export default found")
+	}
+	p.pos = loc[1]
+
+	if name, ok := p.identifierRef(); ok {
+		// export default config; -> const config = {...}
+		decl := regexp.MustCompile(`(?:const|let|var)\s+` + regexp.QuoteMeta(name) + `\s*(?::[^=]+)?=\s*`)
+		loc := decl.FindStringIndex(p.src)
+		if loc == nil {
+			return nil, fmt.Errorf("exported %s is not declared in the file", name)
+		}
+		p.pos = loc[1]
+		if _, ok := p.identifierRef(); ok {
+			return nil, fmt.Errorf("exported %s is not an object literal", name)
+		}
+	}
+
+	value, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	config, ok := value.(map[string]any)
+	if !ok {
+		return nil, errors.New("exported config is not an object literal")
+	}
+	return config, nil
+}
+
+// parseJSON5 parses a JSON5 document whose top level is an object. JSON5
+// is the same static subset of JavaScript that configs export.
+func parseJSON5(data []byte) (map[string]any, error) {
+	p := &jsParser{src: string(data)}
+	value, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	p.skip()
+	if p.pos < len(p.src) {
+		return nil, p.errorf("unexpected %q after the top-level object", p.peek())
+	}
+	config, ok := value.(map[string]any)
+	if !ok {
+		return nil, errors.New("top-level value is not an object")
+	}
+	return config, nil
+}
+
+// jsParser reads the static subset of JavaScript values: object and array
+// literals, strings, numbers, booleans and null.
+type jsParser struct {
+	src string
+	pos int
+}
+
+// identifierRef reads an identifier at the current position. A following
+// call, as in defineConfig({...}), is entered and reported as not being a
+// reference.
+func (p *jsParser) identifierRef() (string, bool) {
+	p.skip()
+	start := p.pos
+	name := p.identifier()
+	if name == "" || name == "true" || name == "false" || name == "null" {
+		p.pos = start
+		return "", false
+	}
+	p.skip()
+	if p.peek() == '(' {
+		p.pos++
+		return "", false
+	}
+	return name, true
+}
+
+// skip moves past whitespace and comments.
+func (p *jsParser) skip() {
+	for p.pos < len(p.src) {
+		switch {
+		case strings.ContainsRune(" \t\r\n", rune(p.src[p.pos])):
+			p.pos++
+		case strings.HasPrefix(p.src[p.pos:], "//"):
+			end := strings.IndexByte(p.src[p.pos:], '\n')
+			if end < 0 {
+				p.pos = len(p.src)
+				return
+			}
+			p.pos += end + 1
+		case strings.HasPrefix(p.src[p.pos:], "/*"):
+			end := strings.Index(p.src[p.pos+2:], "*/")
+			if end < 0 {
+				p.pos = len(p.src)
+				return
+			}
+			p.pos += end + 4
+		default:
+			return
+		}
+	}
+}
+
+// peek returns the current byte, or 0 at the end of input.
+func (p *jsParser) peek() byte {
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+// errorf reports a parse error with the line it occurred on.
+func (p *jsParser) errorf(format string, args ...any) error {
+	line := 1 + strings.Count(p.src[:min(p.pos, len(p.src))], "\n")
+	return fmt.Errorf("line %d: %s", line, fmt.Sprintf(format, args...))
+}
+
+// value reads any supported value.
+func (p *jsParser) value() (any, error) {
+	p.skip()
+	switch c := p.peek(); {
+	case c == '{':
+		return p.object()
+	case c == '[':
+		return p.array()
+	case c == '"' || c == '\'' || c == '`':
+		return p.string()
+	case c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9'):
+		return p.number()
+	case c == 0:
+		return nil, p.errorf("unexpected end of file")
+	}
+
+	switch name := p.identifier(); name {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null", "undefined":
+		return nil, nil
+	case "":
+		return nil, p.errorf("unexpected %q", p.peek())
+	default:
+		return nil, p.errorf("%s is computed at runtime", name)
+	}
+}
+
+// object reads an object literal.
+func (p *jsParser) object() (map[string]any, error) {
+	p.pos++ // {
+	obj := make(map[string]any)
+	for {
+		p.skip()
+		if p.peek() == '}' {
+			p.pos++
+			return obj, nil
+		}
+		if strings.HasPrefix(p.src[p.pos:], "...") {
+			return nil, p.errorf("spread is computed at runtime")
+		}
+
+		var key string
+		switch c := p.peek(); {
+		case c == '"' || c == '\'' || c == '`':
+			s, err := p.string()
+			if err != nil {
+				return nil, err
+			}
+			key = s
+		default:
+			key = p.identifier()
+			if key == "" {
+				return nil, p.errorf("expected a property name, found %q", c)
+			}
+		}
+
+		p.skip()
+		if p.peek() != ':' {
+			return nil, p.errorf("property %s has no static value", key)
+		}
+		p.pos++
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		obj[key] = value
+
+		p.skip()
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case '}':
+		default:
+			return nil, p.errorf("expected , or } after property %s", key)
+		}
+	}
+}
+
+// array reads an array literal.
+func (p *jsParser) array() ([]any, error) {
+	p.pos++ // [
+	arr := []any{}
+	for {
+		p.skip()
+		if p.peek() == ']' {
+			p.pos++
+			return arr, nil
+		}
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		arr = append(arr, value)
+
+		p.skip()
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ']':
+		default:
+			return nil, p.errorf("expected , or ] in array")
+		}
+	}
+}
+
+// string reads a quoted string. Template literals are accepted only
+// without ${...} interpolation.
+func (p *jsParser) string() (string, error) {
+	quote := p.src[p.pos]
+	p.pos++
+	var b strings.Builder
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case c == quote:
+			p.pos++
+			return b.String(), nil
+		case c == '\\' && p.pos+1 < len(p.src):
+			p.pos++
+			switch e := p.src[p.pos]; e {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case '\n':
+				// Line continuation
+			case 'u':
+				if p.pos+5 <= len(p.src) {
+					if r, err := strconv.ParseUint(p.src[p.pos+1:p.pos+5], 16, 32); err == nil {
+						b.WriteRune(rune(r))
+						p.pos += 4
+						break
+					}
+				}
+				b.WriteByte(e)
+			default:
+				b.WriteByte(e)
+			}
+			p.pos++
+		case quote == '`' && strings.HasPrefix(p.src[p.pos:], "${"):
+			return "", p.errorf("template literal interpolation is computed at runtime")
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+// number reads a numeric literal as a float64, matching encoding/json.
+func (p *jsParser) number() (float64, error) {
+	start := p.pos
+	if hex := strings.TrimLeft(p.src[p.pos:], "+-"); strings.HasPrefix(hex, "0x") || strings.HasPrefix(hex, "0X") {
+		p.pos = len(p.src) - len(hex) + 2
+		for p.pos < len(p.src) && strings.IndexByte("0123456789abcdefABCDEF", p.src[p.pos]) >= 0 {
+			p.pos++
+		}
+		n, err := strconv.ParseInt(p.src[start:p.pos], 0, 64)
+		if err != nil {
+			return 0, p.errorf("invalid number %s", p.src[start:p.pos])
+		}
+		return float64(n), nil
+	}
+	for p.pos < len(p.src) && strings.IndexByte("0123456789+-.eE_", p.src[p.pos]) >= 0 {
+		p.pos++
+	}
+	n, err := strconv.ParseFloat(strings.ReplaceAll(p.src[start:p.pos], "_", ""), 64)
+	if err != nil {
+		return 0, p.errorf("invalid number %s", p.src[start:p.pos])
+	}
+	return n, nil
+}
+
+// identifier reads a JavaScript identifier, or returns "" when there is
+// none at the current position.
+func (p *jsParser) identifier() string {
+	start := p.pos
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (p.pos > start && c >= '0' && c <= '9') {
+			p.pos++
+			continue
+		}
+		break
+	}
+	return p.src[start:p.pos]
+}
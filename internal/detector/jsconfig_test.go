@@ -0,0 +1,178 @@
+package detector
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseJSConfig(t *testing.T) {
+	want := map[string]any{
+		"branches":  []any{"main", map[string]any{"name": "next", "prerelease": true}},
+		"tagFormat": "v${version}",
+	}
+
+	tests := []struct {
+		name    string
+		src     string
+		want    map[string]any
+		wantErr bool
+	}{
+		{
+			name: "commonjs",
+			src: `// semantic-release config
+module.exports = {
+  branches: ['main', { name: 'next', prerelease: true }],
+  tagFormat: 'v${version}', /* trailing comma */
+};`,
+			want: want,
+		},
+		{
+			name: "esm export default",
+			src: `export default {
+  "branches": ["main", {name: "next", prerelease: true,},],
+  tagFormat: "v${version}"
+}`,
+			want: want,
+		},
+		{
+			name: "defineConfig wrapper",
+			src: `import { defineConfig } from 'release-it';
+
+export default defineConfig({
+  branches: ['main', { name: 'next', prerelease: true }],
+  tagFormat: 'v${version}',
+});`,
+			want: want,
+		},
+		{
+			name:    "interpolated template literal",
+			src:     "module.exports = { tagFormat: `${prefix}${version}` };",
+			wantErr: true,
+		},
+		{
+			name: "exported const",
+			src: `import type { Config } from 'release-it';
+
+const config: Config = defineConfig({
+  git: { tagName: 'v${version}', requireCleanWorkingDir: false },
+  npm: { publish: false },
+  "hooks": { "after:release": "echo done" },
+});
+
+export default config;`,
+			want: map[string]any{
+				"git":   map[string]any{"tagName": "v${version}", "requireCleanWorkingDir": false},
+				"npm":   map[string]any{"publish": false},
+				"hooks": map[string]any{"after:release": "echo done"},
+			},
+		},
+		{
+			name:    "computed value",
+			src:     `module.exports = { branches: process.env.BRANCHES.split(',') };`,
+			wantErr: true,
+		},
+		{
+			name:    "spread",
+			src:     `module.exports = { ...base, tagFormat: 'v${version}' };`,
+			wantErr: true,
+		},
+		{
+			name:    "no export",
+			src:     `console.log("hello")`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseJSConfig([]byte(tt.src))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseJSConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseJSConfig() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetect_JSConfig(t *testing.T) {
+	dir := t.TempDir()
+	src := "export default defineConfig({ branches: ['main'], tagFormat: 'v${version}' });\n"
+	if err := os.WriteFile(filepath.Join(dir, "release.config.mjs"), []byte(src), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	result, err := Detect(dir)
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if result.Tool != ToolSemanticRelease {
+		t.Fatalf("Detect() tool = %v, want %v", result.Tool, ToolSemanticRelease)
+	}
+	if result.ConfigData["_jsConfig"] != nil {
+		t.Fatalf("ConfigData = %v, want the parsed object", result.ConfigData)
+	}
+	if got := result.ConfigData["tagFormat"]; got != "v${version}" {
+		t.Errorf("tagFormat = %v, want v${version}", got)
+	}
+}
+
+func TestParseJSON5(t *testing.T) {
+	src := `// Release branches
+{
+  branches: [
+    'main', // stable releases
+    {name: 'beta', prerelease: true},
+  ],
+  "tagFormat": "v${version}",
+  retries: 0x0A,
+  note: 'first line \
+second line',
+}
+`
+	want := map[string]any{
+		"branches":  []any{"main", map[string]any{"name": "beta", "prerelease": true}},
+		"tagFormat": "v${version}",
+		"retries":   float64(10),
+		"note":      "first line second line",
+	}
+	got, err := parseJSON5([]byte(src))
+	if err != nil {
+		t.Fatalf("parseJSON5() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseJSON5() = %#v, want %#v", got, want)
+	}
+
+	for _, bad := range []string{`["main"]`, `{branches: ["main"]} extra`, "branches:\n  - main\n"} {
+		if _, err := parseJSON5([]byte(bad)); err == nil {
+			t.Errorf("parseJSON5(%q) error = nil, want an error", bad)
+		}
+	}
+}
+
+func TestDetect_JSON5(t *testing.T) {
+	for _, name := range []string{".releaserc", ".releaserc.json5"} {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			src := "{\n  // stable releases only\n  branches: ['main'],\n  tagFormat: 'v${version}',\n}\n"
+			if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+				t.Fatalf("failed to write test file: %v", err)
+			}
+
+			result, err := Detect(dir)
+			if err != nil {
+				t.Fatalf("Detect() error = %v", err)
+			}
+			if result.Tool != ToolSemanticRelease {
+				t.Fatalf("Detect() tool = %v, want %v", result.Tool, ToolSemanticRelease)
+			}
+			if got := result.ConfigData["tagFormat"]; got != "v${version}" {
+				t.Errorf("tagFormat = %v, want v${version}", got)
+			}
+		})
+	}
+}
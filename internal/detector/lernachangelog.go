@@ -0,0 +1,91 @@
+package detector
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+)
+
+// lernaChangelogPlugins are the names the release-it plugin that runs
+// lerna-changelog is published under, current and legacy.
+var lernaChangelogPlugins = []string{"@release-it-plugins/lerna-changelog", "release-it-lerna-changelog"}
+
+// lernaChangelogFiles are where lerna-changelog reads its changelog
+// settings from, in lookup order.
+var lernaChangelogFiles = []string{"lerna.json", "package.json"}
+
+// addLernaChangelogLabels records the label to section mapping of
+// lerna-changelog when a release-it config in dir uses it. The labels are
+// stored in file order in Details["changelogLabels"] as label/section
+// pairs, with the file in Details["changelogLabelsFile"]. Without
+// configured labels nothing is recorded and lerna-changelog's defaults
+// apply.
+func addLernaChangelogLabels(result *Result, dir string, cache *Cache) {
+	plugins, _ := result.ConfigData["plugins"].(map[string]any)
+	used := false
+	for _, name := range lernaChangelogPlugins {
+		if _, ok := plugins[name]; ok {
+			used = true
+		}
+	}
+	if !used {
+		return
+	}
+
+	for _, file := range lernaChangelogFiles {
+		path := filepath.Join(dir, file)
+		data, err := cache.readFile(path)
+		if err != nil {
+			continue
+		}
+		var config struct {
+			Changelog struct {
+				Labels json.RawMessage `json:"labels"`
+			} `json:"changelog"`
+		}
+		if err := json.Unmarshal(data, &config); err != nil || len(config.Changelog.Labels) == 0 {
+			continue
+		}
+		labels, err := orderedLabels(config.Changelog.Labels)
+		if err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("ignoring changelog.labels in %s: %v", path, err))
+			return
+		}
+		if result.Details == nil {
+			result.Details = make(map[string]any)
+		}
+		result.Details["changelogLabels"] = labels
+		result.Details["changelogLabelsFile"] = path
+		return
+	}
+}
+
+// orderedLabels decodes a JSON object of label names to section titles,
+// keeping the order the sections are listed in, which is the order
+// lerna-changelog renders them in.
+func orderedLabels(raw json.RawMessage) ([]any, error) {
+	var labels map[string]string
+	if err := json.Unmarshal(raw, &labels); err != nil {
+		return nil, errors.New("want an object of label names to section titles")
+	}
+
+	var pairs []any
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if _, err := dec.Token(); err != nil { // {
+		return nil, err
+	}
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		label, _ := token.(string)
+		if _, err := dec.Token(); err != nil { // the section title
+			return nil, err
+		}
+		pairs = append(pairs, map[string]any{"label": label, "section": labels[label]})
+	}
+	return pairs, nil
+}
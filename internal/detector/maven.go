@@ -0,0 +1,123 @@
+package detector
+
+import (
+	"encoding/xml"
+	"path/filepath"
+	"strings"
+)
+
+// mavenReleasePlugin is the artifactId of the maven-release-plugin.
+const mavenReleasePlugin = "maven-release-plugin"
+
+// pom holds the pom.xml fields relevant to the maven-release-plugin.
+type pom struct {
+	ArtifactID string `xml:"artifactId"`
+	SCM        struct {
+		Connection          string `xml:"connection"`
+		DeveloperConnection string `xml:"developerConnection"`
+		URL                 string `xml:"url"`
+		Tag                 string `xml:"tag"`
+	} `xml:"scm"`
+	Plugins []pomPlugin `xml:"build>plugins>plugin"`
+	// PluginManagement is where parent POMs usually configure the plugin
+	PluginManagement []pomPlugin `xml:"build>pluginManagement>plugins>plugin"`
+}
+
+// pomPlugin is a <plugin> entry with the release plugin's options.
+type pomPlugin struct {
+	ArtifactID    string `xml:"artifactId"`
+	Configuration struct {
+		TagNameFormat    string `xml:"tagNameFormat"`
+		PushChanges      string `xml:"pushChanges"`
+		ScmCommentPrefix string `xml:"scmCommentPrefix"`
+	} `xml:"configuration"`
+}
+
+// detectMavenRelease looks for a pom.xml that configures the
+// maven-release-plugin.
+func detectMavenRelease(d *Dir) (*Result, error) {
+	path := filepath.Join(d.Path, "pom.xml")
+	raw, err := d.Options.Cache.readFile(path)
+	if err != nil {
+		return nil, nil
+	}
+
+	data, err := parsePOM(raw)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	return newFileResult(ToolMavenRelease, path, data, extractMavenReleaseDetails(data), d.Options, false), nil
+}
+
+// parsePOM extracts the maven-release-plugin settings from a pom.xml into
+// a config map. It returns nil when the plugin is not configured.
+func parsePOM(raw []byte) (map[string]any, error) {
+	var p pom
+	if err := xml.Unmarshal(raw, &p); err != nil {
+		return nil, err
+	}
+
+	var plugin *pomPlugin
+	for _, list := range [][]pomPlugin{p.Plugins, p.PluginManagement} {
+		for i := range list {
+			if list[i].ArtifactID == mavenReleasePlugin {
+				plugin = &list[i]
+				break
+			}
+		}
+		if plugin != nil {
+			break
+		}
+	}
+	if plugin == nil {
+		return nil, nil
+	}
+
+	data := map[string]any{
+		"artifactId": p.ArtifactID,
+	}
+	setNonEmpty(data, "tagNameFormat", plugin.Configuration.TagNameFormat)
+	setNonEmpty(data, "scmCommentPrefix", plugin.Configuration.ScmCommentPrefix)
+	if push := strings.TrimSpace(plugin.Configuration.PushChanges); push != "" {
+		data["pushChanges"] = push == "true"
+	}
+
+	scm := make(map[string]any)
+	setNonEmpty(scm, "connection", p.SCM.Connection)
+	setNonEmpty(scm, "developerConnection", p.SCM.DeveloperConnection)
+	setNonEmpty(scm, "url", p.SCM.URL)
+	setNonEmpty(scm, "tag", p.SCM.Tag)
+	if len(scm) > 0 {
+		data["scm"] = scm
+	}
+
+	return data, nil
+}
+
+// setNonEmpty stores value under key when it is not blank.
+func setNonEmpty(m map[string]any, key, value string) {
+	if value = strings.TrimSpace(value); value != "" {
+		m[key] = value
+	}
+}
+
+// extractMavenReleaseDetails extracts key details from maven-release-plugin
+// config.
+func extractMavenReleaseDetails(data map[string]any) map[string]any {
+	details := make(map[string]any)
+
+	if tagNameFormat, ok := data["tagNameFormat"].(string); ok {
+		details["tagNameFormat"] = tagNameFormat
+	}
+	if scm, ok := data["scm"].(map[string]any); ok {
+		if url, ok := scm["url"].(string); ok {
+			details["scmUrl"] = url
+		}
+	}
+
+	return details
+}
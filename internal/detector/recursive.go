@@ -0,0 +1,208 @@
+package detector
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// PackageResult is a configuration found by DetectRecursive together with
+// the directory it was found in.
+type PackageResult struct {
+	Dir    string
+	Result *Result
+}
+
+// skipDirs lists directories that never hold a project's own release
+// config, such as installed dependencies.
+var skipDirs = map[string]bool{
+	"node_modules": true,
+	"vendor":       true,
+	"dist":         true,
+}
+
+// DetectRecursive detects release tool configuration in root and in the
+// packages below it. In a pnpm or yarn/npm workspace only the workspace
+// packages are scanned and their results get Details["workspacePackage"]
+// set; otherwise every directory is, skipping node_modules, vendor, dist
+// and hidden directories. It stops with ctx.Err() once ctx is done.
+func DetectRecursive(ctx context.Context, root string, opts Options) ([]PackageResult, error) {
+	// Packages overlap with their parents and the workspace root is read
+	// more than once; share file contents for this scan unless the
+	// caller shares them for longer
+	if opts.Cache == nil {
+		opts.Cache = NewCache()
+	}
+
+	var found []PackageResult
+	detectIn := func(dir string, workspace bool) error {
+		result, err := DetectWithOptionsContext(ctx, dir, opts)
+		if errors.Is(err, ErrNoConfigFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if result.Tool == ToolNone {
+			return nil
+		}
+		if workspace {
+			result.Details["workspacePackage"] = true
+		}
+		found = append(found, PackageResult{Dir: dir, Result: result})
+		return nil
+	}
+
+	if err := detectIn(root, false); err != nil {
+		return nil, err
+	}
+
+	packages, isWorkspace, err := workspacePackages(NewDir(root, opts))
+	if err != nil {
+		return nil, err
+	}
+	if !isWorkspace {
+		packages, err = subdirectories(ctx, root)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, dir := range packages {
+		if err := detectIn(dir, isWorkspace); err != nil {
+			return nil, err
+		}
+	}
+	return found, nil
+}
+
+// workspacePackages returns the package directories of a pnpm workspace
+// (pnpm-workspace.yaml) or a yarn/npm workspace (package.json workspaces),
+// and whether root is a workspace at all.
+func workspacePackages(d *Dir) ([]string, bool, error) {
+	var patterns []string
+	if data, err := d.Options.Cache.readFile(filepath.Join(d.Path, "pnpm-workspace.yaml")); err == nil {
+		var ws struct {
+			Packages []string `yaml:"packages"`
+		}
+		if err := yaml.Unmarshal(data, &ws); err != nil {
+			return nil, false, &DetectionError{Op: "parse", Path: filepath.Join(d.Path, "pnpm-workspace.yaml"), Err: err}
+		}
+		patterns = ws.Packages
+	} else if pkg, err := d.PackageJSON(); err == nil {
+		switch ws := pkg["workspaces"].(type) {
+		case []any:
+			patterns = toStrings(ws)
+		case map[string]any:
+			// Yarn classic: {"packages": [...], "nohoist": [...]}
+			list, _ := ws["packages"].([]any)
+			patterns = toStrings(list)
+		}
+	}
+	if len(patterns) == 0 {
+		return nil, false, nil
+	}
+
+	include := make(map[string]bool)
+	exclude := make(map[string]bool)
+	for _, pattern := range patterns {
+		target := include
+		if p, ok := strings.CutPrefix(pattern, "!"); ok {
+			pattern, target = p, exclude
+		}
+		dirs, err := expandWorkspacePattern(d.Path, pattern)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, dir := range dirs {
+			target[dir] = true
+		}
+	}
+
+	var dirs []string
+	for dir := range include {
+		if !exclude[dir] && dir != filepath.Clean(d.Path) {
+			dirs = append(dirs, dir)
+		}
+	}
+	sort.Strings(dirs)
+	return dirs, true, nil
+}
+
+// expandWorkspacePattern returns the directories under root matching a
+// workspace glob such as "packages/*" or "apps/**". A trailing /** matches
+// every package directory below the prefix.
+func expandWorkspacePattern(root, pattern string) ([]string, error) {
+	pattern = strings.TrimSuffix(strings.TrimPrefix(pattern, "./"), "/")
+	if base, ok := strings.CutSuffix(pattern, "/**"); ok {
+		var dirs []string
+		err := filepath.WalkDir(filepath.Join(root, base), func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return nil // A missing prefix matches nothing
+			}
+			if !entry.IsDir() {
+				return nil
+			}
+			if skipDirs[entry.Name()] {
+				return filepath.SkipDir
+			}
+			if _, err := os.Stat(filepath.Join(path, "package.json")); err == nil {
+				dirs = append(dirs, path)
+			}
+			return nil
+		})
+		return dirs, err
+	}
+
+	matches, err := filepath.Glob(filepath.Join(root, pattern))
+	if err != nil {
+		return nil, err
+	}
+	var dirs []string
+	for _, match := range matches {
+		if info, err := os.Stat(match); err == nil && info.IsDir() && !skipDirs[filepath.Base(match)] {
+			dirs = append(dirs, match)
+		}
+	}
+	return dirs, nil
+}
+
+// subdirectories lists every directory below root that may hold a
+// project, skipping dependency, build output and hidden directories.
+func subdirectories(ctx context.Context, root string) ([]string, error) {
+	var dirs []string
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if !entry.IsDir() || path == root {
+			return nil
+		}
+		if skipDirs[entry.Name()] || strings.HasPrefix(entry.Name(), ".") {
+			return filepath.SkipDir
+		}
+		dirs = append(dirs, path)
+		return nil
+	})
+	return dirs, err
+}
+
+// toStrings returns the string items of a list, ignoring the rest.
+func toStrings(list []any) []string {
+	var out []string
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,187 @@
+package detector
+
+import "sort"
+
+// Detector finds one release tool's configuration in a directory.
+type Detector struct {
+	Tool Tool
+	// Priority orders detectors; lower values run first. Detectors with
+	// the same priority run in registration order.
+	Priority int
+	// ConfigFiles lists where the configuration is looked for. It is only
+	// used to describe the tool to users.
+	ConfigFiles []string
+	// Detect returns a Result, or nil when the directory has no config
+	// for Tool.
+	Detect func(*Dir) (*Result, error)
+	// Details summarizes a parsed config for display. It is optional.
+	Details func(map[string]any) map[string]any
+}
+
+// registry holds the registered detectors sorted by priority.
+var registry []Detector
+
+// Register adds a detector. A tool may register several detectors, e.g.
+// one for config files and a lower priority one for CI workflows.
+// Register is meant to be called from init functions and is not safe for
+// concurrent use with detection.
+func Register(d Detector) {
+	registry = append(registry, d)
+	sort.SliceStable(registry, func(i, j int) bool {
+		return registry[i].Priority < registry[j].Priority
+	})
+}
+
+// Detectors returns the registered detectors in the order they run.
+func Detectors() []Detector {
+	return append([]Detector(nil), registry...)
+}
+
+// fallbackPriority is the priority from which detectors look for
+// indirect evidence of a tool, such as a CI workflow or a Makefile
+// target, rather than its config file.
+const fallbackPriority = 100
+
+// preferred returns the registered detectors with those of the tools in
+// prefer moved ahead, in the order prefer lists them. A config file still
+// beats fallback evidence: the preference applies among the config file
+// detectors and among the fallbacks separately.
+func preferred(prefer []Tool) []Detector {
+	detectors := Detectors()
+	if len(prefer) == 0 {
+		return detectors
+	}
+	rank := func(tool Tool) int {
+		for i, t := range prefer {
+			if t == tool {
+				return i
+			}
+		}
+		return len(prefer)
+	}
+	sort.SliceStable(detectors, func(i, j int) bool {
+		fi, fj := detectors[i].Priority >= fallbackPriority, detectors[j].Priority >= fallbackPriority
+		if fi != fj {
+			return fj
+		}
+		return rank(detectors[i].Tool) < rank(detectors[j].Tool)
+	})
+	return detectors
+}
+
+// ToolInfo describes where a supported tool's configuration is found.
+type ToolInfo struct {
+	Tool        Tool
+	ConfigFiles []string
+}
+
+// ToolInfos describes every supported tool in detection order, merging
+// the config files of all detectors registered for a tool.
+func ToolInfos() []ToolInfo {
+	var infos []ToolInfo
+	index := make(map[Tool]int)
+	for _, d := range registry {
+		i, ok := index[d.Tool]
+		if !ok {
+			i = len(infos)
+			index[d.Tool] = i
+			infos = append(infos, ToolInfo{Tool: d.Tool})
+		}
+		infos[i].ConfigFiles = append(infos[i].ConfigFiles, d.ConfigFiles...)
+	}
+	return infos
+}
+
+// Tools returns every supported release tool in detection order.
+func Tools() []Tool {
+	infos := ToolInfos()
+	tools := make([]Tool, 0, len(infos))
+	for _, info := range infos {
+		tools = append(tools, info.Tool)
+	}
+	return tools
+}
+
+// concat returns a new slice holding a followed by b.
+func concat(a, b []string) []string {
+	return append(append([]string(nil), a...), b...)
+}
+
+func init() {
+	Register(Detector{
+		Tool:        ToolSemanticRelease,
+		Priority:    10,
+		ConfigFiles: concat(semanticReleaseFiles, []string{"package.json (release key)"}),
+		Detect:      detectSemanticRelease,
+		Details:     extractSemanticReleaseDetails,
+	})
+	Register(Detector{
+		Tool:        ToolReleaseIt,
+		Priority:    20,
+		ConfigFiles: concat(releaseItFiles, []string{"package.json (release-it key)"}),
+		Detect:      detectReleaseIt,
+		Details:     extractReleaseItDetails,
+	})
+	Register(Detector{
+		Tool:        ToolStandardVersion,
+		Priority:    30,
+		ConfigFiles: concat(standardVersionFiles, []string{"package.json (standard-version key)"}),
+		Detect:      detectStandardVersion,
+		Details:     extractStandardVersionDetails,
+	})
+	Register(Detector{
+		Tool:        ToolGoReleaser,
+		Priority:    40,
+		ConfigFiles: goReleaserFiles,
+		Detect:      detectGoReleaser,
+		Details:     extractGoReleaserDetails,
+	})
+	Register(Detector{
+		Tool:        ToolReleaseDrafter,
+		Priority:    50,
+		ConfigFiles: releaseDrafterFiles,
+		Detect:      detectReleaseDrafter,
+		Details:     extractReleaseDrafterDetails,
+	})
+	Register(Detector{
+		Tool:        ToolChangesets,
+		Priority:    55,
+		ConfigFiles: changesetsFiles,
+		Detect:      detectChangesets,
+		Details:     extractChangesetsDetails,
+	})
+	Register(Detector{
+		Tool:        ToolMavenRelease,
+		Priority:    60,
+		ConfigFiles: []string{"pom.xml (" + mavenReleasePlugin + ")"},
+		Detect:      detectMavenRelease,
+		Details:     extractMavenReleaseDetails,
+	})
+	// Workflow inputs are the last resort for semantic-release
+	Register(Detector{
+		Tool:        ToolSemanticRelease,
+		Priority:    fallbackPriority,
+		ConfigFiles: []string{".github/workflows/*.yml (" + semanticReleaseAction + ")"},
+		Detect:      detectGitHubActions,
+	})
+	// A release target that runs the tool is evidence even without a
+	// config file in a standard location
+	for _, tool := range []Tool{ToolSemanticRelease, ToolReleaseIt, ToolStandardVersion, ToolGoReleaser} {
+		Register(Detector{
+			Tool:        tool,
+			Priority:    fallbackPriority + 10,
+			ConfigFiles: []string{"Makefile/Taskfile.yml (" + string(tool) + " invocation)"},
+			Detect:      taskRunnerDetector(tool),
+		})
+	}
+	// A tool that is installed but has no config at all runs on its
+	// defaults
+	for _, tool := range []Tool{ToolSemanticRelease, ToolReleaseIt, ToolStandardVersion} {
+		Register(Detector{
+			Tool:        tool,
+			Priority:    fallbackPriority + 20,
+			ConfigFiles: []string{"package.json (" + string(tool) + " in dependencies, defaults only)"},
+			Detect:      dependencyDetector(tool),
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package detector
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+// DefaultTimeout bounds fetching a remote config when Options.Timeout is
+// not set.
+const DefaultTimeout = 30 * time.Second
+
+// maxRemoteSize caps how much of a remote config is read.
+const maxRemoteSize = 10 << 20
+
+// gzipMagic is the header that starts every gzip stream.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// isURL reports whether location is an http(s) URL.
+func isURL(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
+
+// sourceName returns the file name used to infer the tool and format of a
+// config location, without any query string or .gz suffix.
+func sourceName(location string) string {
+	name := location
+	if isURL(location) {
+		if u, err := url.Parse(location); err == nil {
+			name = path.Base(u.Path)
+		}
+	}
+	return strings.TrimSuffix(name, ".gz")
+}
+
+// readSource reads a config from a local path or an http(s) URL and
+// transparently decompresses gzip content.
+func readSource(location string, opts Options) ([]byte, error) {
+	var data []byte
+	var err error
+	if isURL(location) {
+		data, err = opts.Cache.fetch(location, opts.Timeout)
+	} else {
+		data, err = os.ReadFile(location)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.HasPrefix(data, gzipMagic) {
+		return data, nil
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid gzip data: %w", err)
+	}
+	defer zr.Close()
+	return io.ReadAll(io.LimitReader(zr, maxRemoteSize))
+}
+
+// fetch downloads a remote config, failing on any non-200 response.
+func fetch(location string, timeout time.Duration) ([]byte, error) {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	client := &http.Client{Timeout: timeout}
+
+	resp, err := client.Get(location)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status %s", resp.Status)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, maxRemoteSize))
+}
@@ -0,0 +1,143 @@
+package detector
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// taskRunnerFiles lists the Makefiles and Taskfiles scanned for release
+// tool invocations, with the name reported in Details["detectedVia"].
+var taskRunnerFiles = []struct {
+	name string
+	via  string
+}{
+	{"Makefile", "Makefile"},
+	{"makefile", "Makefile"},
+	{"GNUmakefile", "Makefile"},
+	{"Taskfile.yml", "Taskfile"},
+	{"Taskfile.yaml", "Taskfile"},
+}
+
+// taskRunnerCommands maps the command each tool is invoked as to the tool
+// and the short flag, if any, that names its config file.
+var taskRunnerCommands = map[string]struct {
+	tool       Tool
+	configFlag string
+}{
+	"semantic-release": {ToolSemanticRelease, ""},
+	"release-it":       {ToolReleaseIt, "-c"},
+	"standard-version": {ToolStandardVersion, ""},
+	"goreleaser":       {ToolGoReleaser, "-f"},
+}
+
+// taskRunnerCall is a release tool invocation found in a task runner file.
+type taskRunnerCall struct {
+	tool Tool
+	file string
+	via  string
+	// config is the --config argument of the invocation, if any.
+	config string
+}
+
+// taskRunnerCalls returns the release tool invocations in the directory's
+// Makefile and Taskfile, in file order. The files are scanned on the first
+// call only.
+func (d *Dir) taskRunnerCalls() []taskRunnerCall {
+	if d.callsRead {
+		return d.calls
+	}
+	d.callsRead = true
+
+	for _, f := range taskRunnerFiles {
+		path := filepath.Join(d.Path, f.name)
+		content, err := d.Options.Cache.readFile(path)
+		if err != nil {
+			continue
+		}
+		scanner := bufio.NewScanner(bytes.NewReader(content))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			if call, ok := parseTaskRunnerLine(line); ok {
+				call.file, call.via = path, f.via
+				d.calls = append(d.calls, call)
+			}
+		}
+	}
+	return d.calls
+}
+
+// parseTaskRunnerLine finds a release tool invocation in one line of a
+// Makefile recipe or Taskfile command, e.g. "@npx semantic-release" or
+// "- goreleaser release --config build/goreleaser.yml".
+func parseTaskRunnerLine(line string) (taskRunnerCall, bool) {
+	fields := strings.Fields(line)
+	for i, field := range fields {
+		name := filepath.Base(strings.TrimLeft(strings.Trim(field, `"'`), "@-+"))
+		name, _, _ = strings.Cut(name, "@") // npx semantic-release@22
+		cmd, ok := taskRunnerCommands[name]
+		if !ok {
+			continue
+		}
+
+		call := taskRunnerCall{tool: cmd.tool}
+		args := fields[i+1:]
+		for j, arg := range args {
+			arg = strings.Trim(arg, `"'`)
+			if value, ok := strings.CutPrefix(arg, "--config="); ok {
+				call.config = value
+				break
+			}
+			if (arg == "--config" || (cmd.configFlag != "" && arg == cmd.configFlag)) && j+1 < len(args) {
+				call.config = strings.Trim(args[j+1], `"'`)
+				break
+			}
+		}
+		// Make and Task variables cannot be resolved here
+		if strings.ContainsAny(call.config, "${") {
+			call.config = ""
+		}
+		return call, true
+	}
+	return taskRunnerCall{}, false
+}
+
+// taskRunnerDetector returns a detector for tool invoked from a Makefile
+// or Taskfile. It reads the config file passed with --config when there
+// is one and otherwise converts the tool's defaults.
+func taskRunnerDetector(tool Tool) func(*Dir) (*Result, error) {
+	return func(d *Dir) (*Result, error) {
+		for _, call := range d.taskRunnerCalls() {
+			if call.tool != tool {
+				continue
+			}
+
+			result := &Result{
+				Tool:       tool,
+				ConfigFile: call.file,
+				ConfigData: make(map[string]any),
+			}
+			if call.config != "" {
+				path := call.config
+				if !filepath.IsAbs(path) {
+					path = filepath.Join(d.Path, path)
+				}
+				if data, err := readConfigFile(path, d.Options.Cache); err == nil {
+					result.ConfigFile, result.ConfigData = path, data
+				} else {
+					result.Warnings = append(result.Warnings,
+						fmt.Sprintf("%s passes --config %s but it could not be read; using %s defaults", call.file, call.config, tool))
+				}
+			}
+			result.Details = extractDetails(tool, result.ConfigData)
+			result.Details["detectedVia"] = call.via
+			return result, nil
+		}
+		return nil, nil
+	}
+}
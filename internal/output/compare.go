@@ -0,0 +1,139 @@
+package output
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/relicta-tech/migrate/internal/converter"
+)
+
+// Capability statuses in a comparison.
+const (
+	statusClean   = "maps cleanly"
+	statusCaveats = "with caveats"
+	statusMissing = "no equivalent"
+)
+
+// capability is one top-level feature of the source config and how it
+// carries over to Relicta.
+type capability struct {
+	source   string
+	fields   []string
+	status   string
+	warnings []converter.Warning
+}
+
+// capabilities groups a conversion by top-level source key. A key is
+// clean when it was mapped without warnings, has caveats when a warning
+// names the key or a field mapped from it, and has no equivalent when
+// nothing was mapped from it. Warnings that belong to no key are
+// returned separately.
+func capabilities(conv *converter.Conversion, source map[string]any) ([]capability, []converter.Warning) {
+	fields := make(map[string][]string)
+	for _, m := range conv.Mappings() {
+		key := m.SourceKey()
+		fields[key] = append(fields[key], m.Field)
+	}
+
+	keys := make([]string, 0, len(source))
+	for key := range source {
+		if !strings.HasPrefix(key, "_") {
+			keys = append(keys, key)
+		}
+	}
+	for key := range fields {
+		if _, ok := source[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	caps := make([]capability, 0, len(keys))
+	claimed := make([]bool, len(conv.Warnings))
+	for _, key := range keys {
+		c := capability{source: key, fields: fields[key], status: statusClean}
+		for i, w := range conv.Warnings {
+			if warningConcerns(w, key, c.fields) {
+				c.warnings = append(c.warnings, w)
+				claimed[i] = true
+			}
+		}
+		switch {
+		case len(c.fields) == 0:
+			c.status = statusMissing
+		case len(c.warnings) > 0:
+			c.status = statusCaveats
+		}
+		caps = append(caps, c)
+	}
+
+	var other []converter.Warning
+	for i, w := range conv.Warnings {
+		if !claimed[i] {
+			other = append(other, w)
+		}
+	}
+	return caps, other
+}
+
+// warningConcerns reports whether w is about the source key or one of the
+// Relicta fields mapped from it.
+func warningConcerns(w converter.Warning, key string, fields []string) bool {
+	if w.Field == "" {
+		return false
+	}
+	if w.Field == key || strings.HasPrefix(w.Field, key+".") {
+		return true
+	}
+	for _, field := range fields {
+		if w.Field == field || strings.HasPrefix(field, w.Field+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// Compare renders a side-by-side capability comparison between the source
+// tool's config and the converted Relicta config, followed by the caveats
+// and a one-line summary.
+func Compare(tool string, conv *converter.Conversion, source map[string]any) (string, error) {
+	caps, other := capabilities(conv, source)
+
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "%s\tRELICTA\tSTATUS\n", strings.ToUpper(tool))
+	counts := make(map[string]int)
+	for _, c := range caps {
+		relicta := strings.Join(c.fields, ", ")
+		if relicta == "" {
+			relicta = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", c.source, relicta, c.status)
+		counts[c.status]++
+	}
+	if err := w.Flush(); err != nil {
+		return "", err
+	}
+
+	var caveats []string
+	for _, c := range caps {
+		for _, warning := range c.warnings {
+			caveats = append(caveats, c.source+": "+warning.Message)
+		}
+	}
+	for _, warning := range other {
+		caveats = append(caveats, warning.String())
+	}
+	if len(caveats) > 0 {
+		b.WriteString("\nCaveats:\n")
+		for _, caveat := range caveats {
+			fmt.Fprintf(&b, "  - %s\n", caveat)
+		}
+	}
+
+	fmt.Fprintf(&b, "\n%d %s, %d %s, %d %s\n",
+		counts[statusClean], statusClean, counts[statusCaveats], statusCaveats, counts[statusMissing], statusMissing)
+	return b.String(), nil
+}
@@ -0,0 +1,73 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/relicta-tech/migrate/internal/converter"
+)
+
+func TestCompare(t *testing.T) {
+	conv := &converter.Conversion{
+		Config: converter.NewDefaultConfig("v", "main"),
+		Provenance: map[string]string{
+			"versioning.tag_prefix": "from tagFormat",
+			"git.allowed_branches":  "from branches",
+			"plugins.npm":           "from plugins[@semantic-release/npm]",
+			"project.name":          "from name",
+			"changelog.file":        "default changelog file",
+		},
+		Warnings: []converter.Warning{
+			{Field: "plugins.npm", Message: "npm tokens are read from NPM_TOKEN"},
+			{Message: "review the generated config"},
+		},
+	}
+	source := map[string]any{
+		"tagFormat": "v${version}",
+		"branches":  []any{"main"},
+		"plugins":   []any{"@semantic-release/npm"},
+		"dryRun":    true,
+		"_comment":  "ignored",
+	}
+
+	got, err := Compare("semantic-release", conv, source)
+	if err != nil {
+		t.Fatalf("Compare() error = %v", err)
+	}
+
+	rows := make(map[string][]string)
+	for _, line := range strings.Split(got, "\n") {
+		if fields := strings.Fields(line); len(fields) > 0 {
+			rows[fields[0]] = fields[1:]
+		}
+	}
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"tagFormat", "versioning.tag_prefix maps cleanly"},
+		{"branches", "git.allowed_branches maps cleanly"},
+		{"plugins", "plugins.npm with caveats"},
+		{"dryRun", "- no equivalent"},
+		// Mapped from outside the source config, e.g. package.json
+		{"name", "project.name maps cleanly"},
+	}
+	for _, tt := range tests {
+		if row := strings.Join(rows[tt.key], " "); row != tt.want {
+			t.Errorf("row %s = %q, want %q\n%s", tt.key, row, tt.want, got)
+		}
+	}
+	if _, ok := rows["_comment"]; ok {
+		t.Errorf("Compare() lists _comment:\n%s", got)
+	}
+
+	for _, want := range []string{
+		"  - plugins: npm tokens are read from NPM_TOKEN\n",
+		"  - review the generated config\n",
+		"\n3 maps cleanly, 1 with caveats, 1 no equivalent\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Compare() missing %q:\n%s", want, got)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// RemoveJSONKey removes a top-level key from a JSON object without
+// re-encoding the document, so indentation, key order and the trailing
+// newline are kept. It reports whether the key was present.
+func RemoveJSONKey(data []byte, key string) ([]byte, bool, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, false, fmt.Errorf("not a JSON object")
+	}
+	open := int(dec.InputOffset())
+
+	prevEnd := open
+	for first := true; dec.More(); first = false {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, false, err
+		}
+		name, _ := tok.(string)
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, false, err
+		}
+		end := int(dec.InputOffset())
+
+		if name != key {
+			prevEnd = end
+			continue
+		}
+
+		var out []byte
+		switch {
+		case !first:
+			// Drop ",<space>"key": value" after the previous member
+			out = append(append(out, data[:prevEnd]...), data[end:]...)
+		case dec.More():
+			// Drop the first member up to the start of the second
+			start := skipSpace(data, open)
+			next := bytes.IndexByte(data[end:], ',')
+			if next < 0 {
+				return nil, false, fmt.Errorf("malformed JSON object")
+			}
+			next = skipSpace(data, end+next+1)
+			out = append(append(out, data[:start]...), data[next:]...)
+		default:
+			// Only member: keep the braces and the closing whitespace
+			out = append(append(out, data[:open]...), data[end:]...)
+		}
+		return out, true, nil
+	}
+	return data, false, nil
+}
+
+// skipSpace returns the index of the first non-whitespace byte at or
+// after i.
+func skipSpace(data []byte, i int) int {
+	for i < len(data) && bytes.IndexByte([]byte(" \t\r\n"), data[i]) >= 0 {
+		i++
+	}
+	return i
+}
+
+// RemoveJSONFileKey removes a top-level key from a JSON file in place,
+// preserving its formatting. It reports whether the key was present.
+func RemoveJSONFileKey(path, key string) (bool, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+	out, removed, err := RemoveJSONKey(data, key)
+	if err != nil || !removed {
+		return false, err
+	}
+	return true, os.WriteFile(path, out, info.Mode().Perm())
+}
@@ -0,0 +1,126 @@
+package output
+
+import "testing"
+
+func TestRemoveJSONKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		in          string
+		key         string
+		want        string
+		wantRemoved bool
+	}{
+		{
+			name:        "first member",
+			in:          `{"a":1,"b":2,"c":3}`,
+			key:         "a",
+			want:        `{"b":2,"c":3}`,
+			wantRemoved: true,
+		},
+		{
+			name:        "middle member",
+			in:          `{"a":1,"b":2,"c":3}`,
+			key:         "b",
+			want:        `{"a":1,"c":3}`,
+			wantRemoved: true,
+		},
+		{
+			name:        "last member",
+			in:          `{"a":1,"b":2,"c":3}`,
+			key:         "c",
+			want:        `{"a":1,"b":2}`,
+			wantRemoved: true,
+		},
+		{
+			name:        "only member",
+			in:          `{"a":1}`,
+			key:         "a",
+			want:        `{}`,
+			wantRemoved: true,
+		},
+		{
+			name:        "indented first member",
+			in:          "{\n  \"a\": {\n    \"x\": [1, 2]\n  },\n  \"b\": 2\n}\n",
+			key:         "a",
+			want:        "{\n  \"b\": 2\n}\n",
+			wantRemoved: true,
+		},
+		{
+			name:        "indented middle member",
+			in:          "{\n  \"a\": 1,\n  \"b\": \"two\",\n  \"c\": 3\n}\n",
+			key:         "b",
+			want:        "{\n  \"a\": 1,\n  \"c\": 3\n}\n",
+			wantRemoved: true,
+		},
+		{
+			name:        "indented last member",
+			in:          "{\n  \"a\": 1,\n  \"b\": 2\n}\n",
+			key:         "b",
+			want:        "{\n  \"a\": 1\n}\n",
+			wantRemoved: true,
+		},
+		{
+			name:        "indented only member",
+			in:          "{\n  \"a\": 1\n}\n",
+			key:         "a",
+			want:        "{\n}\n",
+			wantRemoved: true,
+		},
+		{
+			name:        "CRLF first member",
+			in:          "{\r\n  \"a\": 1,\r\n  \"b\": 2\r\n}\r\n",
+			key:         "a",
+			want:        "{\r\n  \"b\": 2\r\n}\r\n",
+			wantRemoved: true,
+		},
+		{
+			name:        "CRLF last member",
+			in:          "{\r\n  \"a\": 1,\r\n  \"b\": 2\r\n}\r\n",
+			key:         "b",
+			want:        "{\r\n  \"a\": 1\r\n}\r\n",
+			wantRemoved: true,
+		},
+		{
+			name:        "nested key of the same name is kept",
+			in:          `{"x":{"a":1},"a":2}`,
+			key:         "a",
+			want:        `{"x":{"a":1}}`,
+			wantRemoved: true,
+		},
+		{
+			name: "only a nested key",
+			in:   `{"x":{"a":1}}`,
+			key:  "a",
+			want: `{"x":{"a":1}}`,
+		},
+		{
+			name: "missing key",
+			in:   "{\n  \"a\": 1\n}\n",
+			key:  "b",
+			want: "{\n  \"a\": 1\n}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, removed, err := RemoveJSONKey([]byte(tt.in), tt.key)
+			if err != nil {
+				t.Fatalf("RemoveJSONKey() error = %v", err)
+			}
+			if removed != tt.wantRemoved {
+				t.Errorf("RemoveJSONKey() removed = %v, want %v", removed, tt.wantRemoved)
+			}
+			if string(got) != tt.want {
+				t.Errorf("RemoveJSONKey() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoveJSONKey_NotAnObject(t *testing.T) {
+	for _, in := range []string{`["a"]`, `"a"`, `1`, ``, `{"a":`} {
+		if _, _, err := RemoveJSONKey([]byte(in), "a"); err == nil {
+			t.Errorf("RemoveJSONKey(%q) error = nil, want an error", in)
+		}
+	}
+}
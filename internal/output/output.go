@@ -2,35 +2,480 @@
 package output
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
 	"os"
+	"strings"
+	"text/tabwriter"
 
 	"gopkg.in/yaml.v3"
 
 	"github.com/relicta-tech/migrate/internal/converter"
 )
 
-// ToYAML converts a RelictaConfig to YAML string.
+// header is prepended to every generated config.
+const header = `# Relicta Release Configuration
+# Generated by relicta-migrate
+# Documentation: https://github.com/relicta-tech/relicta
+
+`
+
+// ToYAML converts a RelictaConfig to YAML string. Multi-line strings, such
+// as commit messages with a body, are written as literal block scalars.
 func ToYAML(config *converter.RelictaConfig) (string, error) {
+	return toYAML(config, 0)
+}
+
+// toYAML is ToYAML with indent spaces per level, as marshalYAML takes it.
+func toYAML(config *converter.RelictaConfig, indent int) (string, error) {
+	var node yaml.Node
+	if err := node.Encode(config); err != nil {
+		return "", err
+	}
+	literalStrings(&node)
+
+	data, err := marshalYAML(&node, indent)
+	if err != nil {
+		return "", err
+	}
+
+	return header + string(data), nil
+}
+
+// marshalYAML encodes node with indent spaces per nesting level. Zero
+// keeps yaml.v3's default of 4.
+func marshalYAML(node *yaml.Node, indent int) ([]byte, error) {
+	if indent == 0 {
+		return yaml.Marshal(node)
+	}
+	var b bytes.Buffer
+	enc := yaml.NewEncoder(&b)
+	enc.SetIndent(indent)
+	if err := enc.Encode(node); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// literalStrings marks every multi-line string scalar for the literal
+// block style, which keeps its line breaks as written. The encoder falls
+// back to a quoted string when the value cannot be a block scalar.
+func literalStrings(node *yaml.Node) {
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!str" && strings.Contains(node.Value, "\n") {
+		node.Style = yaml.LiteralStyle
+	}
+	for _, child := range node.Content {
+		literalStrings(child)
+	}
+}
+
+// Format is an output serialization format.
+type Format string
+
+// Supported output formats.
+const (
+	FormatYAML Format = "yaml"
+	FormatJSON Format = "json"
+)
+
+// Render serializes a RelictaConfig in the given format.
+func Render(config *converter.RelictaConfig, format Format) (string, error) {
+	switch format {
+	case FormatYAML, "":
+		return ToYAML(config)
+	case FormatJSON:
+		return ToJSON(config)
+	default:
+		return "", fmt.Errorf("unsupported output format %q (want yaml or json)", format)
+	}
+}
+
+// ToJSON converts a RelictaConfig to an indented JSON string using the
+// same field names as the YAML output.
+func ToJSON(config *converter.RelictaConfig) (string, error) {
+	return ToJSONIndent(config, 0)
+}
+
+// ToJSONIndent is like ToJSON with indent spaces per nesting level. Zero
+// keeps the default of 2.
+func ToJSONIndent(config *converter.RelictaConfig, indent int) (string, error) {
+	if indent == 0 {
+		indent = 2
+	}
+	generic, err := toMap(config)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := json.MarshalIndent(generic, "", strings.Repeat(" ", indent))
+	if err != nil {
+		return "", err
+	}
+	return string(out) + "\n", nil
+}
+
+// toMap converts a RelictaConfig to a generic map keyed by YAML field names.
+func toMap(config *converter.RelictaConfig) (map[string]any, error) {
 	data, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var generic map[string]any
+	if err := yaml.Unmarshal(data, &generic); err != nil {
+		return nil, err
+	}
+	return generic, nil
+}
+
+// maxExplainValue caps the width of the value column in Explain.
+const maxExplainValue = 60
+
+// Explain renders a table mapping each source key to the Relicta field it
+// became and the resulting value, followed by a row for every top-level
+// source key that was not migrated.
+func Explain(conv *converter.Conversion, source map[string]any) (string, error) {
+	generic, err := toMap(conv.Config)
 	if err != nil {
 		return "", err
 	}
 
-	// Add header comment
-	header := `# Relicta Release Configuration
-# Generated by relicta-migrate
-# Documentation: https://github.com/relicta-tech/relicta
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SOURCE\tRELICTA FIELD\tVALUE")
 
-`
-	return header + string(data), nil
+	for _, m := range conv.Mappings() {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", m.Source, m.Field, explainValue(lookup(generic, m.Field)))
+	}
+	for _, key := range conv.UnmappedKeys(source) {
+		fmt.Fprintf(w, "%s\t(not migrated)\t%s\n", key, explainValue(source[key]))
+	}
+
+	if err := w.Flush(); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// lookup returns the value at a dotted field path in a generic config map.
+// List items with a name key (plugins) are addressed by name.
+func lookup(node any, path string) any {
+	for _, part := range strings.Split(path, ".") {
+		switch n := node.(type) {
+		case map[string]any:
+			node = n[part]
+		case []any:
+			var found any
+			for _, item := range n {
+				if m, ok := item.(map[string]any); ok && m["name"] == part {
+					found = m
+					break
+				}
+			}
+			node = found
+		default:
+			return nil
+		}
+	}
+	return node
+}
+
+// explainValue formats a value compactly for the Explain table.
+func explainValue(value any) string {
+	var s string
+	switch v := value.(type) {
+	case nil:
+		return "-"
+	case string:
+		s = v
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			s = fmt.Sprint(v)
+		} else {
+			s = string(data)
+		}
+	}
+	if len(s) > maxExplainValue {
+		s = s[:maxExplainValue-3] + "..."
+	}
+	return s
+}
+
+// ToYAMLWithComments converts a conversion result to YAML, annotating each
+// field with where its value came from and listing warnings at the top.
+func ToYAMLWithComments(conv *converter.Conversion) (string, error) {
+	return toYAMLWithComments(conv, 0)
+}
+
+// toYAMLWithComments is ToYAMLWithComments with indent spaces per level.
+func toYAMLWithComments(conv *converter.Conversion, indent int) (string, error) {
+	var node yaml.Node
+	if err := node.Encode(conv.Config); err != nil {
+		return "", err
+	}
+	literalStrings(&node)
+	annotate(&node, "", conv.Provenance)
+
+	data, err := marshalYAML(&node, indent)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	b.WriteString(header)
+	if len(conv.Warnings) > 0 {
+		b.WriteString("# Needs manual attention:\n")
+		for _, w := range conv.Warnings {
+			b.WriteString("#   - " + w.String() + "\n")
+		}
+		b.WriteString("\n")
+	}
+	b.Write(data)
+
+	return b.String(), nil
+}
+
+// annotate attaches provenance notes as head comments to mapping keys.
+// Sequence items with a name key (plugins) are addressed as path.<name>
+// and annotated with a line comment on the name.
+func annotate(node *yaml.Node, path string, provenance map[string]string) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			field := joinPath(path, key.Value)
+			if note, ok := provenance[field]; ok {
+				key.HeadComment = note
+			}
+			annotate(value, field, provenance)
+		}
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			if item.Kind != yaml.MappingNode {
+				continue
+			}
+			for i := 0; i+1 < len(item.Content); i += 2 {
+				if item.Content[i].Value != "name" {
+					continue
+				}
+				field := joinPath(path, item.Content[i+1].Value)
+				if note, ok := provenance[field]; ok {
+					item.Content[i+1].LineComment = note
+				}
+			}
+		}
+	}
+}
+
+// joinPath joins a parent field path and a key with a dot.
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// YAMLOptions controls how a conversion result is rendered as YAML.
+type YAMLOptions struct {
+	// Comments annotates each field with where its value came from, as
+	// ToYAMLWithComments does.
+	Comments bool
+	// DocumentStart begins the output with a "---" document marker, which
+	// some YAML linters require.
+	DocumentStart bool
+	// Indent is the number of spaces per nesting level. Zero keeps the
+	// default of 4.
+	Indent int
+}
+
+// ToYAMLWithOptions converts a conversion result to YAML as opts describe.
+func ToYAMLWithOptions(conv *converter.Conversion, opts YAMLOptions) (string, error) {
+	var content string
+	var err error
+	if opts.Comments {
+		content, err = toYAMLWithComments(conv, opts.Indent)
+	} else {
+		content, err = toYAML(conv.Config, opts.Indent)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if opts.DocumentStart {
+		content = "---\n" + content
+	}
+	return content, nil
+}
+
+// Write serializes a RelictaConfig in the given format to w.
+func Write(w io.Writer, config *converter.RelictaConfig, format Format) error {
+	content, err := Render(config, format)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, content)
+	return err
+}
+
+// WriteWithComments writes an annotated conversion result as YAML to w.
+func WriteWithComments(w io.Writer, conv *converter.Conversion) error {
+	content, err := ToYAMLWithComments(conv)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, content)
+	return err
 }
 
 // WriteYAML writes a RelictaConfig to a YAML file.
 func WriteYAML(path string, config *converter.RelictaConfig) error {
-	content, err := ToYAML(config)
+	return writeFile(path, func(w io.Writer) error {
+		return Write(w, config, FormatYAML)
+	})
+}
+
+// WriteYAMLWithComments writes an annotated conversion result to a YAML file.
+func WriteYAMLWithComments(path string, conv *converter.Conversion) error {
+	return writeFile(path, func(w io.Writer) error {
+		return WriteWithComments(w, conv)
+	})
+}
+
+// pluginsHeader is prepended to a split plugins file.
+const pluginsHeader = `# Relicta Plugins
+# Generated by relicta-migrate; referenced by plugins_file in the main config
+# Documentation: https://github.com/relicta-tech/relicta
+
+`
+
+// SplitPlugins returns a copy of conv whose config references
+// pluginsFile through plugins_file instead of listing the plugins, for
+// writing the plugins to their own file with ToPluginsYAML. conv is
+// returned as is when it has no plugins.
+func SplitPlugins(conv *converter.Conversion, pluginsFile string) *converter.Conversion {
+	if len(conv.Config.Plugins) == 0 {
+		return conv
+	}
+	config := *conv.Config
+	config.Plugins = nil
+	config.PluginsFile = pluginsFile
+	main := *conv
+	main.Config = &config
+	return &main
+}
+
+// ToPluginsYAML renders the plugins of a conversion result as a plugins
+// file. With opts.Comments the plugins are annotated as in the main
+// config.
+func ToPluginsYAML(conv *converter.Conversion, opts YAMLOptions) (string, error) {
+	doc := struct {
+		Plugins []converter.PluginConfig `yaml:"plugins"`
+	}{conv.Config.Plugins}
+
+	var node yaml.Node
+	if err := node.Encode(doc); err != nil {
+		return "", err
+	}
+	literalStrings(&node)
+	if opts.Comments {
+		annotate(&node, "", conv.Provenance)
+	}
+
+	data, err := marshalYAML(&node, opts.Indent)
+	if err != nil {
+		return "", err
+	}
+
+	content := pluginsHeader + string(data)
+	if opts.DocumentStart {
+		content = "---\n" + content
+	}
+	return content, nil
+}
+
+// WritePluginsYAML writes the plugins of a conversion result to a plugins
+// file as opts describe.
+func WritePluginsYAML(path string, conv *converter.Conversion, opts YAMLOptions) error {
+	return writeFile(path, func(w io.Writer) error {
+		content, err := ToPluginsYAML(conv, opts)
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(w, content)
+		return err
+	})
+}
+
+// WriteYAMLWithOptions writes a conversion result to a YAML file as opts
+// describe.
+func WriteYAMLWithOptions(path string, conv *converter.Conversion, opts YAMLOptions) error {
+	return writeFile(path, func(w io.Writer) error {
+		content, err := ToYAMLWithOptions(conv, opts)
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(w, content)
+		return err
+	})
+}
+
+// writeFile creates or truncates path and hands it to write.
+func writeFile(path string, write func(io.Writer) error) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Report summarizes a migration run in machine-readable form.
+type Report struct {
+	Tool       string              `json:"tool"`
+	SourceFile string              `json:"source_file"`
+	OutputFile string              `json:"output_file"`
+	Plugins    []string            `json:"plugins"`
+	Warnings   []converter.Warning `json:"warnings"`
+	DryRun     bool                `json:"dry_run"`
+	Written    bool                `json:"written"`
+	Error      string              `json:"error,omitempty"`
+}
+
+// NewReport builds a Report from a detection result and its conversion.
+func NewReport(tool, sourceFile, outputFile string, conv *converter.Conversion) *Report {
+	report := &Report{
+		Tool:       tool,
+		SourceFile: sourceFile,
+		OutputFile: outputFile,
+		Plugins:    []string{},
+		Warnings:   []converter.Warning{},
+	}
+
+	for _, p := range conv.Config.Plugins {
+		report.Plugins = append(report.Plugins, p.Name)
+	}
+	report.Warnings = append(report.Warnings, conv.Warnings...)
+
+	return report
+}
+
+// WriteReport writes a Report as indented JSON.
+func WriteReport(path string, report *Report) error {
+	data, err := json.MarshalIndent(report, "", "  ")
 	if err != nil {
 		return err
 	}
 
-	return os.WriteFile(path, []byte(content), 0644)
+	return os.WriteFile(path, append(data, '\n'), 0644)
 }
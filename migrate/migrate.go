@@ -0,0 +1,208 @@
+// Package migrate exposes the migration logic for use from other Go
+// programs. It is a thin wrapper around the packages used by the CLI.
+//
+// A typical caller detects the release tool in a directory, converts its
+// configuration and renders the result:
+//
+//	result, err := migrate.Detect(dir)
+//	if err != nil {
+//		return err
+//	}
+//	config, err := migrate.Convert(result)
+//	if err != nil {
+//		return err
+//	}
+//	out, err := migrate.Render(config, migrate.FormatYAML)
+package migrate
+
+import (
+	"context"
+	"io"
+
+	"github.com/relicta-tech/migrate/internal/converter"
+	"github.com/relicta-tech/migrate/internal/detector"
+	"github.com/relicta-tech/migrate/internal/output"
+)
+
+// Tool identifies a source release management tool.
+type Tool = detector.Tool
+
+// Supported source tools.
+const (
+	ToolNone            = detector.ToolNone
+	ToolSemanticRelease = detector.ToolSemanticRelease
+	ToolReleaseIt       = detector.ToolReleaseIt
+	ToolStandardVersion = detector.ToolStandardVersion
+	ToolGoReleaser      = detector.ToolGoReleaser
+	ToolReleaseDrafter  = detector.ToolReleaseDrafter
+	ToolMavenRelease    = detector.ToolMavenRelease
+	ToolChangesets      = detector.ToolChangesets
+)
+
+// Result is a detected source configuration.
+type Result = detector.Result
+
+// Options controls how configuration files are located.
+type Options = detector.Options
+
+// Cache shares files read and includes fetched between the detections of
+// one run; set it in Options.Cache.
+type Cache = detector.Cache
+
+// NewCache returns an empty Cache.
+func NewCache() *Cache {
+	return detector.NewCache()
+}
+
+// PackageResult is a configuration found by DetectRecursive and the
+// directory it was found in.
+type PackageResult = detector.PackageResult
+
+// Errors returned by detection and conversion. Use errors.Is and
+// errors.As to branch on them.
+var (
+	// ErrNoConfigFound reports that no release tool configuration exists
+	// where one was required.
+	ErrNoConfigFound = detector.ErrNoConfigFound
+	// ErrUnsupportedTool reports a tool or config file name that is not
+	// supported.
+	ErrUnsupportedTool = detector.ErrUnsupportedTool
+)
+
+// DetectionError reports a config file that could not be read or parsed.
+type DetectionError = detector.DetectionError
+
+// ConflictError reports a package.json with config for several tools.
+type ConflictError = detector.ConflictError
+
+// Config is a Relicta release.config.yaml structure.
+type Config = converter.RelictaConfig
+
+// Conversion is a converted config together with warnings and provenance.
+type Conversion = converter.Conversion
+
+// Warning describes a source setting that needs manual attention.
+type Warning = converter.Warning
+
+// Source is a converted config together with the file it came from.
+type Source = converter.Source
+
+// Format is an output serialization format.
+type Format = output.Format
+
+// Supported output formats.
+const (
+	FormatYAML = output.FormatYAML
+	FormatJSON = output.FormatJSON
+)
+
+// Detect identifies the release tool configuration in dir.
+func Detect(dir string) (*Result, error) {
+	return detector.Detect(dir)
+}
+
+// DetectContext is like Detect but stops with ctx.Err() once ctx is done.
+func DetectContext(ctx context.Context, dir string) (*Result, error) {
+	return detector.DetectContext(ctx, dir)
+}
+
+// DetectWithOptions identifies the release tool configuration in dir using
+// the supplied options.
+func DetectWithOptions(dir string, opts Options) (*Result, error) {
+	return detector.DetectWithOptions(dir, opts)
+}
+
+// DetectWithOptionsContext is like DetectWithOptions but stops with
+// ctx.Err() once ctx is done.
+func DetectWithOptionsContext(ctx context.Context, dir string, opts Options) (*Result, error) {
+	return detector.DetectWithOptionsContext(ctx, dir, opts)
+}
+
+// DetectAll returns the configuration of every release tool found in dir.
+func DetectAll(dir string, opts Options) ([]*Result, error) {
+	return detector.DetectAll(dir, opts)
+}
+
+// DetectAllContext is like DetectAll but stops with ctx.Err() once ctx is
+// done.
+func DetectAllContext(ctx context.Context, dir string, opts Options) ([]*Result, error) {
+	return detector.DetectAllContext(ctx, dir, opts)
+}
+
+// DetectRecursive returns the configuration found in dir and in each
+// package below it, following pnpm and yarn/npm workspaces when dir is
+// one. Results for workspace packages have Details["workspacePackage"] set.
+func DetectRecursive(ctx context.Context, dir string, opts Options) ([]PackageResult, error) {
+	return detector.DetectRecursive(ctx, dir, opts)
+}
+
+// DetectFile reads an explicitly named config file.
+func DetectFile(path string, opts Options) (*Result, error) {
+	return detector.DetectFile(path, opts)
+}
+
+// PluginMapping maps a semantic-release plugin to a Relicta plugin.
+type PluginMapping = converter.PluginMapping
+
+// RegisterPluginMapping maps the semantic-release plugin named source, as
+// written in the config, ahead of the built-in mappings.
+func RegisterPluginMapping(source string, m PluginMapping) {
+	converter.RegisterPluginMapping(source, m)
+}
+
+// ParsePluginMap reads a JSON plugin map as accepted by --plugin-map.
+func ParsePluginMap(data []byte) (map[string]PluginMapping, error) {
+	return converter.ParsePluginMap(data)
+}
+
+// Convert transforms a detected config to Relicta format.
+func Convert(result *Result) (*Config, error) {
+	return converter.Convert(result)
+}
+
+// ConvertDetailed transforms a detected config to Relicta format and
+// reports any settings that need manual attention.
+func ConvertDetailed(result *Result) (*Conversion, error) {
+	return converter.ConvertDetailed(result)
+}
+
+// CheckConsistency warns about settings, such as the tag prefix, that
+// configs converted from the same repository disagree on.
+func CheckConsistency(sources []Source) []Warning {
+	return converter.CheckConsistency(sources)
+}
+
+// ToolConversion is a conversion together with the tool it was converted
+// from.
+type ToolConversion = converter.ToolConversion
+
+// Merge combines the conversions of several tools configured in one
+// repository into the first and warns about the fields and plugins they
+// disagree on. prefer maps a config section (e.g. "plugins") to the tool
+// whose values win there; by default GoReleaser's win in plugins and the
+// versioning tool's everywhere else.
+func Merge(convs []ToolConversion, prefer map[string]Tool) *Conversion {
+	return converter.Merge(convs, prefer)
+}
+
+// ParseMergePrefer reads section=tool pairs, as accepted by
+// --merge-prefer, into the prefer argument of Merge.
+func ParseMergePrefer(values []string) (map[string]Tool, error) {
+	return converter.ParseMergePrefer(values)
+}
+
+// ValidateSource warns about settings in a detected source config that
+// contradict each other, such as a GitHub release without a pushed tag.
+func ValidateSource(result *Result) []Warning {
+	return converter.ValidateSource(result)
+}
+
+// Render serializes a config in the given format.
+func Render(config *Config, format Format) (string, error) {
+	return output.Render(config, format)
+}
+
+// Write serializes a config in the given format to w.
+func Write(w io.Writer, config *Config, format Format) error {
+	return output.Write(w, config, format)
+}
@@ -0,0 +1,147 @@
+package migrate
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestDetectConvertRender(t *testing.T) {
+	dir := t.TempDir()
+	content := `{"tagFormat": "v${version}", "branches": ["main"], "plugins": ["@semantic-release/github"]}`
+	if err := os.WriteFile(filepath.Join(dir, ".releaserc.json"), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	result, err := Detect(dir)
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if result.Tool != ToolSemanticRelease {
+		t.Fatalf("Detect() tool = %v, want %v", result.Tool, ToolSemanticRelease)
+	}
+
+	config, err := Convert(result)
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+
+	yamlOut, err := Render(config, FormatYAML)
+	if err != nil {
+		t.Fatalf("Render(yaml) error = %v", err)
+	}
+	if !strings.Contains(yamlOut, "tag_prefix: v") {
+		t.Errorf("Render(yaml) = %q, want tag_prefix: v", yamlOut)
+	}
+
+	jsonOut, err := Render(config, FormatJSON)
+	if err != nil {
+		t.Fatalf("Render(json) error = %v", err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal([]byte(jsonOut), &decoded); err != nil {
+		t.Fatalf("Render(json) produced invalid JSON: %v", err)
+	}
+	versioning, _ := decoded["versioning"].(map[string]any)
+	if versioning["tag_prefix"] != "v" {
+		t.Errorf("versioning.tag_prefix = %v, want v", versioning["tag_prefix"])
+	}
+
+	if _, err := Render(config, "toml"); err == nil {
+		t.Error("Render(toml) should return error")
+	}
+}
+
+func TestDetectConvert_ReleaseItTOML(t *testing.T) {
+	dir := t.TempDir()
+	content := "[git]\ntagName = \"v${version}\"\n\n[github]\nrelease = true\n"
+	if err := os.WriteFile(filepath.Join(dir, ".release-it.toml"), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	result, err := Detect(dir)
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	if result.Tool != ToolReleaseIt {
+		t.Fatalf("Detect() tool = %v, want %v", result.Tool, ToolReleaseIt)
+	}
+
+	config, err := Convert(result)
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+	if config.Versioning.TagPrefix != "v" {
+		t.Errorf("TagPrefix = %q, want v", config.Versioning.TagPrefix)
+	}
+	if len(config.Plugins) != 1 || config.Plugins[0].Name != "github" {
+		t.Errorf("Plugins = %v, want github", config.Plugins)
+	}
+}
+
+func TestRender_MultiLineCommitMessage(t *testing.T) {
+	dir := t.TempDir()
+	content := `{"git": {"commitMessage": "chore: release v${version}\n\n  ${changelog}\n\nSigned-off-by: Release Bot"}}`
+	if err := os.WriteFile(filepath.Join(dir, ".release-it.json"), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	result, err := Detect(dir)
+	if err != nil {
+		t.Fatalf("Detect() error = %v", err)
+	}
+	config, err := Convert(result)
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+	out, err := Render(config, FormatYAML)
+	if err != nil {
+		t.Fatalf("Render(yaml) error = %v", err)
+	}
+	if !strings.Contains(out, "commit_message: |") {
+		t.Errorf("Render(yaml) = %q, want commit_message as a literal block", out)
+	}
+
+	var decoded struct {
+		Git struct {
+			CommitMessage string `yaml:"commit_message"`
+		} `yaml:"git"`
+	}
+	if err := yaml.Unmarshal([]byte(out), &decoded); err != nil {
+		t.Fatalf("Render(yaml) produced invalid YAML: %v", err)
+	}
+	if decoded.Git.CommitMessage != config.Git.CommitMessage {
+		t.Errorf("commit_message round-tripped as %q, want %q", decoded.Git.CommitMessage, config.Git.CommitMessage)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	config, err := Convert(&Result{
+		Tool:       ToolSemanticRelease,
+		ConfigData: map[string]any{"tagFormat": "v${version}"},
+	})
+	if err != nil {
+		t.Fatalf("Convert() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, config, FormatYAML); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	want, err := Render(config, FormatYAML)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if buf.String() != want {
+		t.Errorf("Write() wrote %q, want %q", buf.String(), want)
+	}
+
+	if err := Write(&buf, config, "toml"); err == nil {
+		t.Error("Write(toml) should return error")
+	}
+}